@@ -0,0 +1,214 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package applicationsignals
+
+import (
+	"context"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/service/applicationsignals"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/applicationsignals/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_applicationsignals_grouping_configuration", name="Grouping Configuration")
+// @SingletonIdentity(identityDuplicateAttributes="id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/applicationsignals;applicationsignals.ListGroupingAttributeDefinitionsOutput")
+// @Testing(generator=false)
+// @Testing(serialize=true)
+// @Testing(hasNoPreExistingResource=true)
+func newGroupingConfigurationResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &groupingConfigurationResource{}, nil
+}
+
+type groupingConfigurationResource struct {
+	framework.ResourceWithModel[groupingConfigurationResourceModel]
+	framework.WithImportByIdentity
+}
+
+func (r *groupingConfigurationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttributeDeprecatedWithAlternate(path.Root(names.AttrRegion)),
+			"updated_at": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"grouping_attribute_definition": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[groupingAttributeDefinitionModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"default_grouping_value": schema.StringAttribute{
+							Optional: true,
+						},
+						"grouping_name": schema.StringAttribute{
+							Required: true,
+						},
+						"grouping_source_keys": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *groupingConfigurationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan groupingConfigurationResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &plan))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	r.put(ctx, &plan, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// The grouping configuration is applied per Region, so use the Region as the ID.
+	plan.ID = fwflex.StringValueToFramework(ctx, r.Meta().Region(ctx))
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, plan))
+}
+
+func (r *groupingConfigurationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	conn := r.Meta().ApplicationSignalsClient(ctx)
+
+	var state groupingConfigurationResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &state))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := findGroupingConfiguration(ctx, conn)
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &state))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &state))
+}
+
+func (r *groupingConfigurationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan groupingConfigurationResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &plan))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	r.put(ctx, &plan, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &plan))
+}
+
+func (r *groupingConfigurationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	conn := r.Meta().ApplicationSignalsClient(ctx)
+
+	var input applicationsignals.DeleteGroupingConfigurationInput
+	_, err := conn.DeleteGroupingConfiguration(ctx, &input)
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err)
+		return
+	}
+}
+
+func (r *groupingConfigurationResource) put(ctx context.Context, data *groupingConfigurationResourceModel, diags *diag.Diagnostics) {
+	conn := r.Meta().ApplicationSignalsClient(ctx)
+
+	var input applicationsignals.PutGroupingConfigurationInput
+	smerr.AddEnrich(ctx, diags, fwflex.Expand(ctx, data, &input))
+	if diags.HasError() {
+		return
+	}
+
+	output, err := conn.PutGroupingConfiguration(ctx, &input)
+	if err != nil {
+		smerr.AddError(ctx, diags, err)
+		return
+	}
+
+	data.UpdatedAt = timetypes.NewRFC3339TimePointerValue(output.GroupingConfiguration.UpdatedAt)
+}
+
+func findGroupingConfiguration(ctx context.Context, conn *applicationsignals.Client) (*applicationsignals.ListGroupingAttributeDefinitionsOutput, error) {
+	var input applicationsignals.ListGroupingAttributeDefinitionsInput
+	output := &applicationsignals.ListGroupingAttributeDefinitionsOutput{}
+
+	for {
+		page, err := conn.ListGroupingAttributeDefinitions(ctx, &input)
+		if err != nil {
+			return nil, smarterr.NewError(err)
+		}
+
+		output.GroupingAttributeDefinitions = append(output.GroupingAttributeDefinitions, page.GroupingAttributeDefinitions...)
+		output.UpdatedAt = page.UpdatedAt
+
+		if page.NextToken == nil {
+			break
+		}
+		input.NextToken = page.NextToken
+	}
+
+	// An account without a grouping configuration has no definitions.
+	if len(output.GroupingAttributeDefinitions) == 0 {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError())
+	}
+
+	return output, nil
+}
+
+type groupingConfigurationResourceModel struct {
+	framework.WithRegionModel
+	GroupingAttributeDefinitions fwtypes.ListNestedObjectValueOf[groupingAttributeDefinitionModel] `tfsdk:"grouping_attribute_definition"`
+	ID                           types.String                                                      `tfsdk:"id"`
+	UpdatedAt                    timetypes.RFC3339                                                 `tfsdk:"updated_at"`
+}
+
+type groupingAttributeDefinitionModel struct {
+	DefaultGroupingValue types.String         `tfsdk:"default_grouping_value"`
+	GroupingName         types.String         `tfsdk:"grouping_name"`
+	GroupingSourceKeys   fwtypes.ListOfString `tfsdk:"grouping_source_keys"`
+}
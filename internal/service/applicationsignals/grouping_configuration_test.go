@@ -0,0 +1,159 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package applicationsignals_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/applicationsignals"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfapplicationsignals "github.com/hashicorp/terraform-provider-aws/internal/service/applicationsignals"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccApplicationSignalsGroupingConfiguration_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]func(t *testing.T){
+		acctest.CtBasic:      testAccGroupingConfiguration_basic,
+		acctest.CtDisappears: testAccGroupingConfiguration_disappears,
+		"Identity":           testAccApplicationSignalsGroupingConfiguration_IdentitySerial,
+	}
+
+	acctest.RunSerialTests1Level(t, testCases, 0)
+}
+
+func testAccGroupingConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v applicationsignals.ListGroupingAttributeDefinitionsOutput
+	resourceName := "aws_applicationsignals_grouping_configuration.test"
+
+	acctest.Test(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationSignalsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGroupingConfigurationDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupingConfigurationConfig_basic("Team", "team"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGroupingConfigurationExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "grouping_attribute_definition.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "grouping_attribute_definition.0.grouping_name", "Team"),
+					resource.TestCheckResourceAttr(resourceName, "grouping_attribute_definition.0.grouping_source_keys.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "grouping_attribute_definition.0.grouping_source_keys.0", "team"),
+					resource.TestCheckResourceAttrSet(resourceName, "updated_at"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrRegion),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrRegion,
+			},
+			{
+				Config: testAccGroupingConfigurationConfig_basic("Owner", "owner"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGroupingConfigurationExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "grouping_attribute_definition.0.grouping_name", "Owner"),
+					resource.TestCheckResourceAttr(resourceName, "grouping_attribute_definition.0.grouping_source_keys.0", "owner"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func testAccGroupingConfiguration_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v applicationsignals.ListGroupingAttributeDefinitionsOutput
+	resourceName := "aws_applicationsignals_grouping_configuration.test"
+
+	acctest.Test(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationSignalsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGroupingConfigurationDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupingConfigurationConfig_basic("Team", "team"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGroupingConfigurationExists(ctx, t, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, t, tfapplicationsignals.ResourceGroupingConfiguration, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGroupingConfigurationDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).ApplicationSignalsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_applicationsignals_grouping_configuration" {
+				continue
+			}
+
+			_, err := tfapplicationsignals.FindGroupingConfiguration(ctx, conn)
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return errors.New("Application Signals Grouping Configuration still exists")
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckGroupingConfigurationExists(ctx context.Context, t *testing.T, n string, v *applicationsignals.ListGroupingAttributeDefinitionsOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).ApplicationSignalsClient(ctx)
+
+		output, err := tfapplicationsignals.FindGroupingConfiguration(ctx, conn)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccGroupingConfigurationConfig_basic(groupingName, sourceKey string) string {
+	return fmt.Sprintf(`
+resource "aws_applicationsignals_grouping_configuration" "test" {
+  grouping_attribute_definition {
+    grouping_name        = %[1]q
+    grouping_source_keys = [%[2]q]
+  }
+}
+`, groupingName, sourceKey)
+}
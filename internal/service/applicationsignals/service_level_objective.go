@@ -0,0 +1,1031 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package applicationsignals
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/applicationsignals"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/applicationsignals/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_applicationsignals_service_level_objective", name="Service Level Objective")
+// @Tags(identifierAttribute="arn")
+// @IdentityAttribute("name")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/applicationsignals/types;awstypes;awstypes.ServiceLevelObjective")
+// @Testing(importStateIdAttribute="name")
+// @Testing(hasNoPreExistingResource=true)
+func newServiceLevelObjectiveResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &serviceLevelObjectiveResource{}, nil
+}
+
+type serviceLevelObjectiveResource struct {
+	framework.ResourceWithModel[serviceLevelObjectiveResourceModel]
+	framework.WithImportByIdentity
+}
+
+func (r *serviceLevelObjectiveResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	dependencyConfigBlock := schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[dependencyConfigModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"dependency_key_attributes": schema.MapAttribute{
+					CustomType:  fwtypes.MapOfStringType,
+					ElementType: types.StringType,
+					Required:    true,
+				},
+				"dependency_operation_name": schema.StringAttribute{
+					Required: true,
+				},
+			},
+		},
+	}
+	metricDataQueryBlock := func(validators ...validator.List) schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[metricDataQueryModel](ctx),
+			Validators: validators,
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					names.AttrAccountID: schema.StringAttribute{
+						Optional: true,
+						Computed: true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					names.AttrExpression: schema.StringAttribute{
+						Optional: true,
+					},
+					names.AttrID: schema.StringAttribute{
+						Required: true,
+					},
+					"label": schema.StringAttribute{
+						Optional: true,
+					},
+					"period": schema.Int64Attribute{
+						Optional: true,
+					},
+					"return_data": schema.BoolAttribute{
+						Optional: true,
+						Computed: true,
+						PlanModifiers: []planmodifier.Bool{
+							boolplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+				Blocks: map[string]schema.Block{
+					"metric_stat": schema.ListNestedBlock{
+						CustomType: fwtypes.NewListNestedObjectTypeOf[metricStatModel](ctx),
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(1),
+						},
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"period": schema.Int64Attribute{
+									Required: true,
+								},
+								"stat": schema.StringAttribute{
+									Required: true,
+								},
+								names.AttrUnit: schema.StringAttribute{
+									CustomType: fwtypes.StringEnumType[awstypes.StandardUnit](),
+									Optional:   true,
+								},
+							},
+							Blocks: map[string]schema.Block{
+								"metric": schema.ListNestedBlock{
+									CustomType: fwtypes.NewListNestedObjectTypeOf[metricModel](ctx),
+									Validators: []validator.List{
+										listvalidator.IsRequired(),
+										listvalidator.SizeAtMost(1),
+									},
+									NestedObject: schema.NestedBlockObject{
+										Attributes: map[string]schema.Attribute{
+											names.AttrMetricName: schema.StringAttribute{
+												Optional: true,
+											},
+											names.AttrNamespace: schema.StringAttribute{
+												Optional: true,
+											},
+										},
+										Blocks: map[string]schema.Block{
+											"dimension": schema.ListNestedBlock{
+												CustomType: fwtypes.NewListNestedObjectTypeOf[dimensionModel](ctx),
+												Validators: []validator.List{
+													listvalidator.SizeAtMost(30),
+												},
+												NestedObject: schema.NestedBlockObject{
+													Attributes: map[string]schema.Attribute{
+														names.AttrName: schema.StringAttribute{
+															Required: true,
+														},
+														names.AttrValue: schema.StringAttribute{
+															Required: true,
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	windowBlock := func(validators ...validator.List) schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[windowModel](ctx),
+			Validators: append([]validator.List{
+				listvalidator.SizeAtMost(1),
+			}, validators...),
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					names.AttrDuration: schema.Int64Attribute{
+						Required: true,
+					},
+					"duration_unit": schema.StringAttribute{
+						CustomType: fwtypes.StringEnumType[awstypes.DurationUnit](),
+						Required:   true,
+					},
+				},
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrCreatedTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 1024),
+				},
+			},
+			"evaluation_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.EvaluationType](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"metric_source_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.MetricSourceType](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"burn_rate_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[burnRateConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(10),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"look_back_window_minutes": schema.Int64Attribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			"exclusion_window": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[exclusionWindowModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(10),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"reason": schema.StringAttribute{
+							Optional: true,
+						},
+						names.AttrStartTime: schema.StringAttribute{
+							CustomType: timetypes.RFC3339Type{},
+							Optional:   true,
+							Computed:   true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"recurrence_rule": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[recurrenceRuleModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrExpression: schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+						"window": windowBlock(listvalidator.IsRequired()),
+					},
+				},
+			},
+			"goal": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[goalModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"attainment_goal": schema.Float64Attribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Float64{
+								float64planmodifier.UseStateForUnknown(),
+							},
+						},
+						"warning_threshold": schema.Float64Attribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Float64{
+								float64planmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						names.AttrInterval: schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[intervalModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"calendar_interval": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[calendarIntervalModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+											listvalidator.ExactlyOneOf(
+												path.MatchRelative().AtParent().AtName("calendar_interval"),
+												path.MatchRelative().AtParent().AtName("rolling_interval"),
+											),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrDuration: schema.Int64Attribute{
+													Required: true,
+												},
+												"duration_unit": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.DurationUnit](),
+													Required:   true,
+												},
+												names.AttrStartTime: schema.StringAttribute{
+													CustomType: timetypes.RFC3339Type{},
+													Required:   true,
+												},
+											},
+										},
+									},
+									"rolling_interval": windowBlock(),
+								},
+							},
+						},
+					},
+				},
+			},
+			"request_based_sli": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[requestBasedSLIModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+					listvalidator.ExactlyOneOf(
+						path.MatchRoot("request_based_sli"),
+						path.MatchRoot("sli"),
+					),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"comparison_operator": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.ServiceLevelIndicatorComparisonOperator](),
+							Optional:   true,
+						},
+						"metric_threshold": schema.Float64Attribute{
+							Optional: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"request_based_sli_metric": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[requestBasedSLIMetricModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"key_attributes": schema.MapAttribute{
+										CustomType:  fwtypes.MapOfStringType,
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+									"metric_type": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.ServiceLevelIndicatorMetricType](),
+										Optional:   true,
+									},
+									"operation_name": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"dependency_config": dependencyConfigBlock,
+									"monitored_request_count_metric": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[monitoredRequestCountMetricModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Blocks: map[string]schema.Block{
+												"bad_count_metric": metricDataQueryBlock(
+													listvalidator.ExactlyOneOf(
+														path.MatchRelative().AtParent().AtName("bad_count_metric"),
+														path.MatchRelative().AtParent().AtName("good_count_metric"),
+													),
+												),
+												"good_count_metric": metricDataQueryBlock(),
+											},
+										},
+									},
+									"total_request_count_metric": metricDataQueryBlock(),
+								},
+							},
+						},
+					},
+				},
+			},
+			"sli": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[sliModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"comparison_operator": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.ServiceLevelIndicatorComparisonOperator](),
+							Required:   true,
+						},
+						"metric_threshold": schema.Float64Attribute{
+							Required: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"sli_metric": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[sliMetricModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"key_attributes": schema.MapAttribute{
+										CustomType:  fwtypes.MapOfStringType,
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+									names.AttrMetricName: schema.StringAttribute{
+										Optional: true,
+									},
+									"metric_type": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.ServiceLevelIndicatorMetricType](),
+										Optional:   true,
+									},
+									"operation_name": schema.StringAttribute{
+										Optional: true,
+									},
+									"period_seconds": schema.Int64Attribute{
+										Optional: true,
+									},
+									"statistic": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"dependency_config": dependencyConfigBlock,
+									"metric_data_query": metricDataQueryBlock(),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *serviceLevelObjectiveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	conn := r.Meta().ApplicationSignalsClient(ctx)
+
+	var plan serviceLevelObjectiveResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &plan))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	var input applicationsignals.CreateServiceLevelObjectiveInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, plan, &input, fwflex.WithFieldNameSuffix("Config")))
+	if response.Diagnostics.HasError() {
+		return
+	}
+	input.Tags = getTagsIn(ctx)
+
+	_, err := conn.CreateServiceLevelObjective(ctx, &input)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	if len(plan.ExclusionWindows.Elements()) > 0 {
+		var add []awstypes.ExclusionWindow
+		smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, plan.ExclusionWindows, &add))
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if err := updateExclusionWindows(ctx, conn, name, add, nil); err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+			return
+		}
+	}
+
+	output, err := findServiceLevelObjectiveByID(ctx, conn, name)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, flattenServiceLevelObjective(ctx, conn, output, &plan))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, plan))
+}
+
+func (r *serviceLevelObjectiveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	conn := r.Meta().ApplicationSignalsClient(ctx)
+
+	var state serviceLevelObjectiveResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &state))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Name.ValueString()
+	output, err := findServiceLevelObjectiveByID(ctx, conn, name)
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, flattenServiceLevelObjective(ctx, conn, output, &state))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &state))
+}
+
+func (r *serviceLevelObjectiveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	conn := r.Meta().ApplicationSignalsClient(ctx)
+
+	var plan, state serviceLevelObjectiveResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &plan))
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &state))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+
+	diff, d := fwflex.Diff(ctx, plan, state, fwflex.WithIgnoredField("ExclusionWindows"))
+	smerr.AddEnrich(ctx, &response.Diagnostics, d)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		var input applicationsignals.UpdateServiceLevelObjectiveInput
+		smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, plan, &input, fwflex.WithFieldNameSuffix("Config")))
+		if response.Diagnostics.HasError() {
+			return
+		}
+		input.Id = aws.String(name)
+
+		_, err := conn.UpdateServiceLevelObjective(ctx, &input)
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+			return
+		}
+	}
+
+	if !plan.ExclusionWindows.Equal(state.ExclusionWindows) {
+		// Exclusion windows have no identifier, so replace the full set.
+		var add, remove []awstypes.ExclusionWindow
+		smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, plan.ExclusionWindows, &add))
+		smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, state.ExclusionWindows, &remove))
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if err := updateExclusionWindows(ctx, conn, name, add, remove); err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+			return
+		}
+	}
+
+	output, err := findServiceLevelObjectiveByID(ctx, conn, name)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, flattenServiceLevelObjective(ctx, conn, output, &plan))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &plan))
+}
+
+func (r *serviceLevelObjectiveResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	conn := r.Meta().ApplicationSignalsClient(ctx)
+
+	var state serviceLevelObjectiveResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &state))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Name.ValueString()
+	input := applicationsignals.DeleteServiceLevelObjectiveInput{
+		Id: aws.String(name),
+	}
+	_, err := conn.DeleteServiceLevelObjective(ctx, &input)
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+}
+
+// flattenServiceLevelObjective flattens the SLO and adds its exclusion windows.
+// SLI arguments that the API doesn't return are kept from the current value of data.
+func flattenServiceLevelObjective(ctx context.Context, conn *applicationsignals.Client, output *awstypes.ServiceLevelObjective, data *serviceLevelObjectiveResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	priorSLI, priorRequestBasedSLI := data.SLI, data.RequestBasedSLI
+
+	diags.Append(fwflex.Flatten(ctx, output, data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	name := aws.ToString(output.Name)
+	windows, err := findExclusionWindowsByID(ctx, conn, name)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("reading Application Signals Service Level Objective (%s) exclusion windows", name), err.Error())
+		return diags
+	}
+
+	diags.Append(fwflex.Flatten(ctx, windows, &data.ExclusionWindows)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(data.preserveSLIConfiguration(ctx, priorSLI, priorRequestBasedSLI)...)
+
+	return diags
+}
+
+// preserveSLIConfiguration restores SLI arguments that the API doesn't return.
+// The API also expands Application Signals standard metrics into metric data
+// queries, which are kept out of state unless they were configured.
+func (m *serviceLevelObjectiveResourceModel) preserveSLIConfiguration(ctx context.Context, priorSLI fwtypes.ListNestedObjectValueOf[sliModel], priorRequestBasedSLI fwtypes.ListNestedObjectValueOf[requestBasedSLIModel]) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if sli, prior := nestedObjectPtr(ctx, m.SLI, &diags), nestedObjectPtr(ctx, priorSLI, &diags); sli != nil && prior != nil {
+		if metric, priorMetric := nestedObjectPtr(ctx, sli.SLIMetric, &diags), nestedObjectPtr(ctx, prior.SLIMetric, &diags); metric != nil && priorMetric != nil {
+			metric.MetricName = priorMetric.MetricName
+			metric.PeriodSeconds = priorMetric.PeriodSeconds
+			metric.Statistic = priorMetric.Statistic
+			if len(priorMetric.MetricDataQueries.Elements()) == 0 {
+				metric.MetricDataQueries = priorMetric.MetricDataQueries
+			}
+			sli.SLIMetric = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, metric)
+		}
+		m.SLI = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, sli)
+	}
+
+	if sli, prior := nestedObjectPtr(ctx, m.RequestBasedSLI, &diags), nestedObjectPtr(ctx, priorRequestBasedSLI, &diags); sli != nil && prior != nil {
+		if metric, priorMetric := nestedObjectPtr(ctx, sli.RequestBasedSLIMetric, &diags), nestedObjectPtr(ctx, prior.RequestBasedSLIMetric, &diags); metric != nil && priorMetric != nil {
+			if len(priorMetric.MonitoredRequestCountMetric.Elements()) == 0 {
+				metric.MonitoredRequestCountMetric = priorMetric.MonitoredRequestCountMetric
+			}
+			if len(priorMetric.TotalRequestCountMetric.Elements()) == 0 {
+				metric.TotalRequestCountMetric = priorMetric.TotalRequestCountMetric
+			}
+			sli.RequestBasedSLIMetric = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, metric)
+		}
+		m.RequestBasedSLI = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, sli)
+	}
+
+	return diags
+}
+
+func nestedObjectPtr[T any](ctx context.Context, v fwtypes.ListNestedObjectValueOf[T], diags *diag.Diagnostics) *T {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+
+	ptr, d := v.ToPtr(ctx)
+	diags.Append(d...)
+
+	return ptr
+}
+
+func updateExclusionWindows(ctx context.Context, conn *applicationsignals.Client, id string, add, remove []awstypes.ExclusionWindow) error {
+	input := applicationsignals.BatchUpdateExclusionWindowsInput{
+		AddExclusionWindows:    add,
+		RemoveExclusionWindows: remove,
+		SloIds:                 []string{id},
+	}
+
+	output, err := conn.BatchUpdateExclusionWindows(ctx, &input)
+
+	if err == nil && output != nil {
+		err = exclusionWindowsErrors(output.Errors)
+	}
+
+	if err != nil {
+		return fmt.Errorf("updating Application Signals Service Level Objective (%s) exclusion windows: %w", id, err)
+	}
+
+	return nil
+}
+
+func exclusionWindowsErrors(apiObjects []awstypes.BatchUpdateExclusionWindowsError) error {
+	var err error
+
+	for _, apiObject := range apiObjects {
+		err = errors.Join(err, fmt.Errorf("%s: %s: %s", aws.ToString(apiObject.SloId), aws.ToString(apiObject.ErrorCode), aws.ToString(apiObject.ErrorMessage)))
+	}
+
+	return err
+}
+
+func findServiceLevelObjectiveByID(ctx context.Context, conn *applicationsignals.Client, id string) (*awstypes.ServiceLevelObjective, error) {
+	input := applicationsignals.GetServiceLevelObjectiveInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.GetServiceLevelObjective(ctx, &input)
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			LastError: err,
+		})
+	}
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if output == nil || output.Slo == nil {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError())
+	}
+
+	return output.Slo, nil
+}
+
+func findExclusionWindowsByID(ctx context.Context, conn *applicationsignals.Client, id string) ([]awstypes.ExclusionWindow, error) {
+	input := applicationsignals.ListServiceLevelObjectiveExclusionWindowsInput{
+		Id: aws.String(id),
+	}
+	var output []awstypes.ExclusionWindow
+
+	pages := applicationsignals.NewListServiceLevelObjectiveExclusionWindowsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, smarterr.NewError(&retry.NotFoundError{
+				LastError: err,
+			})
+		}
+
+		if err != nil {
+			return nil, smarterr.NewError(err)
+		}
+
+		output = append(output, page.ExclusionWindows...)
+	}
+
+	return output, nil
+}
+
+type serviceLevelObjectiveResourceModel struct {
+	framework.WithRegionModel
+	ARN                    types.String                                                `tfsdk:"arn"`
+	BurnRateConfigurations fwtypes.ListNestedObjectValueOf[burnRateConfigurationModel] `tfsdk:"burn_rate_configuration"`
+	CreatedTime            timetypes.RFC3339                                           `tfsdk:"created_time"`
+	Description            types.String                                                `tfsdk:"description"`
+	EvaluationType         fwtypes.StringEnum[awstypes.EvaluationType]                 `tfsdk:"evaluation_type"`
+	ExclusionWindows       fwtypes.ListNestedObjectValueOf[exclusionWindowModel]       `tfsdk:"exclusion_window" autoflex:"-"`
+	Goal                   fwtypes.ListNestedObjectValueOf[goalModel]                  `tfsdk:"goal"`
+	LastUpdatedTime        timetypes.RFC3339                                           `tfsdk:"last_updated_time"`
+	MetricSourceType       fwtypes.StringEnum[awstypes.MetricSourceType]               `tfsdk:"metric_source_type"`
+	Name                   types.String                                                `tfsdk:"name"`
+	RequestBasedSLI        fwtypes.ListNestedObjectValueOf[requestBasedSLIModel]       `tfsdk:"request_based_sli"`
+	SLI                    fwtypes.ListNestedObjectValueOf[sliModel]                   `tfsdk:"sli"`
+	Tags                   tftags.Map                                                  `tfsdk:"tags"`
+	TagsAll                tftags.Map                                                  `tfsdk:"tags_all"`
+}
+
+type burnRateConfigurationModel struct {
+	LookBackWindowMinutes types.Int64 `tfsdk:"look_back_window_minutes"`
+}
+
+type exclusionWindowModel struct {
+	Reason         types.String                                         `tfsdk:"reason"`
+	RecurrenceRule fwtypes.ListNestedObjectValueOf[recurrenceRuleModel] `tfsdk:"recurrence_rule"`
+	StartTime      timetypes.RFC3339                                    `tfsdk:"start_time"`
+	Window         fwtypes.ListNestedObjectValueOf[windowModel]         `tfsdk:"window"`
+}
+
+type recurrenceRuleModel struct {
+	Expression types.String `tfsdk:"expression"`
+}
+
+type windowModel struct {
+	Duration     types.Int64                               `tfsdk:"duration"`
+	DurationUnit fwtypes.StringEnum[awstypes.DurationUnit] `tfsdk:"duration_unit"`
+}
+
+type goalModel struct {
+	AttainmentGoal   types.Float64                                  `tfsdk:"attainment_goal"`
+	Interval         fwtypes.ListNestedObjectValueOf[intervalModel] `tfsdk:"interval"`
+	WarningThreshold types.Float64                                  `tfsdk:"warning_threshold"`
+}
+
+type intervalModel struct {
+	CalendarInterval fwtypes.ListNestedObjectValueOf[calendarIntervalModel] `tfsdk:"calendar_interval"`
+	RollingInterval  fwtypes.ListNestedObjectValueOf[windowModel]           `tfsdk:"rolling_interval"`
+}
+
+var (
+	_ fwflex.Expander  = intervalModel{}
+	_ fwflex.Flattener = &intervalModel{}
+)
+
+func (m intervalModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.CalendarInterval.IsNull():
+		calendarIntervalData, d := m.CalendarInterval.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.IntervalMemberCalendarInterval
+		diags.Append(fwflex.Expand(ctx, calendarIntervalData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.RollingInterval.IsNull():
+		rollingIntervalData, d := m.RollingInterval.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.IntervalMemberRollingInterval
+		diags.Append(fwflex.Expand(ctx, rollingIntervalData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *intervalModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.IntervalMemberCalendarInterval:
+		var model calendarIntervalModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.CalendarInterval = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+
+	case awstypes.IntervalMemberRollingInterval:
+		var model windowModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.RollingInterval = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+	}
+
+	return diags
+}
+
+type calendarIntervalModel struct {
+	Duration     types.Int64                               `tfsdk:"duration"`
+	DurationUnit fwtypes.StringEnum[awstypes.DurationUnit] `tfsdk:"duration_unit"`
+	StartTime    timetypes.RFC3339                         `tfsdk:"start_time"`
+}
+
+type sliModel struct {
+	ComparisonOperator fwtypes.StringEnum[awstypes.ServiceLevelIndicatorComparisonOperator] `tfsdk:"comparison_operator"`
+	MetricThreshold    types.Float64                                                        `tfsdk:"metric_threshold"`
+	SLIMetric          fwtypes.ListNestedObjectValueOf[sliMetricModel]                      `tfsdk:"sli_metric"`
+}
+
+type sliMetricModel struct {
+	DependencyConfig  fwtypes.ListNestedObjectValueOf[dependencyConfigModel]       `tfsdk:"dependency_config"`
+	KeyAttributes     fwtypes.MapOfString                                          `tfsdk:"key_attributes"`
+	MetricDataQueries fwtypes.ListNestedObjectValueOf[metricDataQueryModel]        `tfsdk:"metric_data_query"`
+	MetricName        types.String                                                 `tfsdk:"metric_name"`
+	MetricType        fwtypes.StringEnum[awstypes.ServiceLevelIndicatorMetricType] `tfsdk:"metric_type"`
+	OperationName     types.String                                                 `tfsdk:"operation_name"`
+	PeriodSeconds     types.Int64                                                  `tfsdk:"period_seconds"`
+	Statistic         types.String                                                 `tfsdk:"statistic"`
+}
+
+type requestBasedSLIModel struct {
+	ComparisonOperator    fwtypes.StringEnum[awstypes.ServiceLevelIndicatorComparisonOperator] `tfsdk:"comparison_operator"`
+	MetricThreshold       types.Float64                                                        `tfsdk:"metric_threshold"`
+	RequestBasedSLIMetric fwtypes.ListNestedObjectValueOf[requestBasedSLIMetricModel]          `tfsdk:"request_based_sli_metric"`
+}
+
+type requestBasedSLIMetricModel struct {
+	DependencyConfig            fwtypes.ListNestedObjectValueOf[dependencyConfigModel]            `tfsdk:"dependency_config"`
+	KeyAttributes               fwtypes.MapOfString                                               `tfsdk:"key_attributes"`
+	MetricType                  fwtypes.StringEnum[awstypes.ServiceLevelIndicatorMetricType]      `tfsdk:"metric_type"`
+	MonitoredRequestCountMetric fwtypes.ListNestedObjectValueOf[monitoredRequestCountMetricModel] `tfsdk:"monitored_request_count_metric"`
+	OperationName               types.String                                                      `tfsdk:"operation_name"`
+	TotalRequestCountMetric     fwtypes.ListNestedObjectValueOf[metricDataQueryModel]             `tfsdk:"total_request_count_metric"`
+}
+
+type monitoredRequestCountMetricModel struct {
+	BadCountMetric  fwtypes.ListNestedObjectValueOf[metricDataQueryModel] `tfsdk:"bad_count_metric"`
+	GoodCountMetric fwtypes.ListNestedObjectValueOf[metricDataQueryModel] `tfsdk:"good_count_metric"`
+}
+
+var (
+	_ fwflex.Expander  = monitoredRequestCountMetricModel{}
+	_ fwflex.Flattener = &monitoredRequestCountMetricModel{}
+)
+
+func (m monitoredRequestCountMetricModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.BadCountMetric.IsNull():
+		var r awstypes.MonitoredRequestCountMetricDataQueriesMemberBadCountMetric
+		diags.Append(fwflex.Expand(ctx, m.BadCountMetric, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.GoodCountMetric.IsNull():
+		var r awstypes.MonitoredRequestCountMetricDataQueriesMemberGoodCountMetric
+		diags.Append(fwflex.Expand(ctx, m.GoodCountMetric, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *monitoredRequestCountMetricModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.MonitoredRequestCountMetricDataQueriesMemberBadCountMetric:
+		diags.Append(fwflex.Flatten(ctx, t.Value, &m.BadCountMetric)...)
+
+		return diags
+
+	case awstypes.MonitoredRequestCountMetricDataQueriesMemberGoodCountMetric:
+		diags.Append(fwflex.Flatten(ctx, t.Value, &m.GoodCountMetric)...)
+
+		return diags
+	}
+
+	return diags
+}
+
+type dependencyConfigModel struct {
+	DependencyKeyAttributes fwtypes.MapOfString `tfsdk:"dependency_key_attributes"`
+	DependencyOperationName types.String        `tfsdk:"dependency_operation_name"`
+}
+
+type metricDataQueryModel struct {
+	AccountID  types.String                                     `tfsdk:"account_id"`
+	Expression types.String                                     `tfsdk:"expression"`
+	ID         types.String                                     `tfsdk:"id"`
+	Label      types.String                                     `tfsdk:"label"`
+	MetricStat fwtypes.ListNestedObjectValueOf[metricStatModel] `tfsdk:"metric_stat"`
+	Period     types.Int64                                      `tfsdk:"period"`
+	ReturnData types.Bool                                       `tfsdk:"return_data"`
+}
+
+type metricStatModel struct {
+	Metric fwtypes.ListNestedObjectValueOf[metricModel] `tfsdk:"metric"`
+	Period types.Int64                                  `tfsdk:"period"`
+	Stat   types.String                                 `tfsdk:"stat"`
+	Unit   fwtypes.StringEnum[awstypes.StandardUnit]    `tfsdk:"unit"`
+}
+
+type metricModel struct {
+	Dimensions fwtypes.ListNestedObjectValueOf[dimensionModel] `tfsdk:"dimension"`
+	MetricName types.String                                    `tfsdk:"metric_name"`
+	Namespace  types.String                                    `tfsdk:"namespace"`
+}
+
+type dimensionModel struct {
+	Name  types.String `tfsdk:"name"`
+	Value types.String `tfsdk:"value"`
+}
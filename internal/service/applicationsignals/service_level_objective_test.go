@@ -0,0 +1,393 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package applicationsignals_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/applicationsignals/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfapplicationsignals "github.com/hashicorp/terraform-provider-aws/internal/service/applicationsignals"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccApplicationSignalsServiceLevelObjective_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.ServiceLevelObjective
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_applicationsignals_service_level_objective.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationSignalsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceLevelObjectiveDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceLevelObjectiveConfig_basic(rName, 99.9),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(ctx, t, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "application-signals", regexache.MustCompile(`slo/.+$`)),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreatedTime),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_type", string(awstypes.EvaluationTypePeriodBased)),
+					resource.TestCheckResourceAttr(resourceName, "goal.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.attainment_goal", "99.9"),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.interval.0.rolling_interval.0.duration", "7"),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.interval.0.rolling_interval.0.duration_unit", "DAY"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "request_based_sli.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "sli.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sli.0.comparison_operator", string(awstypes.ServiceLevelIndicatorComparisonOperatorLessThan)),
+					resource.TestCheckResourceAttr(resourceName, "sli.0.sli_metric.0.metric_data_query.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sli.0.sli_metric.0.metric_data_query.0.id", "cpu"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrName,
+			},
+			{
+				Config: testAccServiceLevelObjectiveConfig_basic(rName, 99.5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "goal.0.attainment_goal", "99.5"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccApplicationSignalsServiceLevelObjective_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.ServiceLevelObjective
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_applicationsignals_service_level_objective.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationSignalsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceLevelObjectiveDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceLevelObjectiveConfig_basic(rName, 99.9),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(ctx, t, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, t, tfapplicationsignals.ResourceServiceLevelObjective, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccApplicationSignalsServiceLevelObjective_requestBased(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.ServiceLevelObjective
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_applicationsignals_service_level_objective.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationSignalsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceLevelObjectiveDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceLevelObjectiveConfig_requestBased(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_type", string(awstypes.EvaluationTypeRequestBased)),
+					resource.TestCheckResourceAttr(resourceName, "request_based_sli.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "request_based_sli.0.request_based_sli_metric.0.monitored_request_count_metric.0.bad_count_metric.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "request_based_sli.0.request_based_sli_metric.0.total_request_count_metric.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sli.#", "0"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrName,
+			},
+		},
+	})
+}
+
+func TestAccApplicationSignalsServiceLevelObjective_burnRateAndExclusionWindow(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.ServiceLevelObjective
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_applicationsignals_service_level_objective.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationSignalsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceLevelObjectiveDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceLevelObjectiveConfig_burnRateAndExclusionWindow(rName, 60, "maintenance"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "burn_rate_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "burn_rate_configuration.0.look_back_window_minutes", "60"),
+					resource.TestCheckResourceAttr(resourceName, "exclusion_window.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "exclusion_window.0.reason", "maintenance"),
+					resource.TestCheckResourceAttr(resourceName, "exclusion_window.0.recurrence_rule.0.expression", "cron(0 2 ? * SUN *)"),
+					resource.TestCheckResourceAttr(resourceName, "exclusion_window.0.window.0.duration", "2"),
+					resource.TestCheckResourceAttr(resourceName, "exclusion_window.0.window.0.duration_unit", "HOUR"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrName,
+			},
+			{
+				Config: testAccServiceLevelObjectiveConfig_burnRateAndExclusionWindow(rName, 120, "patching"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckServiceLevelObjectiveExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "burn_rate_configuration.0.look_back_window_minutes", "120"),
+					resource.TestCheckResourceAttr(resourceName, "exclusion_window.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "exclusion_window.0.reason", "patching"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckServiceLevelObjectiveDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).ApplicationSignalsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_applicationsignals_service_level_objective" {
+				continue
+			}
+
+			_, err := tfapplicationsignals.FindServiceLevelObjectiveByID(ctx, conn, rs.Primary.Attributes[names.AttrName])
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Application Signals Service Level Objective %s still exists", rs.Primary.Attributes[names.AttrName])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckServiceLevelObjectiveExists(ctx context.Context, t *testing.T, n string, v *awstypes.ServiceLevelObjective) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).ApplicationSignalsClient(ctx)
+
+		output, err := tfapplicationsignals.FindServiceLevelObjectiveByID(ctx, conn, rs.Primary.Attributes[names.AttrName])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccServiceLevelObjectiveConfig_basic(rName string, attainmentGoal float64) string {
+	return fmt.Sprintf(`
+resource "aws_applicationsignals_service_level_objective" "test" {
+  name = %[1]q
+
+  sli {
+    comparison_operator = "LessThan"
+    metric_threshold    = 90
+
+    sli_metric {
+      metric_data_query {
+        id          = "cpu"
+        return_data = true
+
+        metric_stat {
+          period = 60
+          stat   = "Average"
+
+          metric {
+            metric_name = "CPUUtilization"
+            namespace   = "AWS/EC2"
+          }
+        }
+      }
+    }
+  }
+
+  goal {
+    attainment_goal = %[2]g
+
+    interval {
+      rolling_interval {
+        duration      = 7
+        duration_unit = "DAY"
+      }
+    }
+  }
+}
+`, rName, attainmentGoal)
+}
+
+func testAccServiceLevelObjectiveConfig_requestBased(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_applicationsignals_service_level_objective" "test" {
+  name = %[1]q
+
+  request_based_sli {
+    request_based_sli_metric {
+      monitored_request_count_metric {
+        bad_count_metric {
+          id          = "errors"
+          return_data = true
+
+          metric_stat {
+            period = 60
+            stat   = "Sum"
+
+            metric {
+              metric_name = "Errors"
+              namespace   = "AWS/Lambda"
+
+              dimension {
+                name  = "FunctionName"
+                value = %[1]q
+              }
+            }
+          }
+        }
+      }
+
+      total_request_count_metric {
+        id          = "invocations"
+        return_data = true
+
+        metric_stat {
+          period = 60
+          stat   = "Sum"
+
+          metric {
+            metric_name = "Invocations"
+            namespace   = "AWS/Lambda"
+
+            dimension {
+              name  = "FunctionName"
+              value = %[1]q
+            }
+          }
+        }
+      }
+    }
+  }
+
+  goal {
+    attainment_goal = 99
+
+    interval {
+      rolling_interval {
+        duration      = 1
+        duration_unit = "DAY"
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccServiceLevelObjectiveConfig_burnRateAndExclusionWindow(rName string, lookBackWindowMinutes int, reason string) string {
+	return fmt.Sprintf(`
+resource "aws_applicationsignals_service_level_objective" "test" {
+  name = %[1]q
+
+  sli {
+    comparison_operator = "LessThan"
+    metric_threshold    = 90
+
+    sli_metric {
+      metric_data_query {
+        id          = "cpu"
+        return_data = true
+
+        metric_stat {
+          period = 60
+          stat   = "Average"
+
+          metric {
+            metric_name = "CPUUtilization"
+            namespace   = "AWS/EC2"
+          }
+        }
+      }
+    }
+  }
+
+  goal {
+    attainment_goal = 99.9
+
+    interval {
+      rolling_interval {
+        duration      = 7
+        duration_unit = "DAY"
+      }
+    }
+  }
+
+  burn_rate_configuration {
+    look_back_window_minutes = %[2]d
+  }
+
+  exclusion_window {
+    reason = %[3]q
+
+    recurrence_rule {
+      expression = "cron(0 2 ? * SUN *)"
+    }
+
+    window {
+      duration      = 2
+      duration_unit = "HOUR"
+    }
+  }
+}
+`, rName, lookBackWindowMinutes, reason)
+}
@@ -0,0 +1,132 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package applicationsignals
+
+import (
+	"context"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/service/applicationsignals"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/applicationsignals/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_applicationsignals_services", name="Services")
+func newServicesDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &servicesDataSource{}, nil
+}
+
+type servicesDataSource struct {
+	framework.DataSourceWithModel[servicesDataSourceModel]
+}
+
+func (d *servicesDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"aws_account_id": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					fwvalidators.AWSAccountID(),
+				},
+			},
+			"end_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Required:   true,
+			},
+			"include_linked_accounts": schema.BoolAttribute{
+				Optional: true,
+			},
+			"services": framework.DataSourceComputedListOfObjectAttribute[serviceSummaryModel](ctx),
+			names.AttrStartTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Required:   true,
+			},
+		},
+	}
+}
+
+func (d *servicesDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	conn := d.Meta().ApplicationSignalsClient(ctx)
+
+	var data servicesDataSourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var input applicationsignals.ListServicesInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, data, &input))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := findServices(ctx, conn, &input)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &data.Services))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func findServices(ctx context.Context, conn *applicationsignals.Client, input *applicationsignals.ListServicesInput) ([]awstypes.ServiceSummary, error) {
+	var output []awstypes.ServiceSummary
+
+	pages := applicationsignals.NewListServicesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, smarterr.NewError(err)
+		}
+
+		output = append(output, page.ServiceSummaries...)
+	}
+
+	return output, nil
+}
+
+type servicesDataSourceModel struct {
+	framework.WithRegionModel
+	AWSAccountID          types.String                                         `tfsdk:"aws_account_id"`
+	EndTime               timetypes.RFC3339                                    `tfsdk:"end_time"`
+	IncludeLinkedAccounts types.Bool                                           `tfsdk:"include_linked_accounts"`
+	Services              fwtypes.ListNestedObjectValueOf[serviceSummaryModel] `tfsdk:"services"`
+	StartTime             timetypes.RFC3339                                    `tfsdk:"start_time"`
+}
+
+type serviceSummaryModel struct {
+	KeyAttributes    fwtypes.MapOfString                                   `tfsdk:"key_attributes"`
+	MetricReferences fwtypes.ListNestedObjectValueOf[metricReferenceModel] `tfsdk:"metric_references"`
+	ServiceGroups    fwtypes.ListNestedObjectValueOf[serviceGroupModel]    `tfsdk:"service_groups"`
+}
+
+type metricReferenceModel struct {
+	AccountID  types.String                                    `tfsdk:"account_id"`
+	Dimensions fwtypes.ListNestedObjectValueOf[dimensionModel] `tfsdk:"dimensions"`
+	MetricName types.String                                    `tfsdk:"metric_name"`
+	MetricType types.String                                    `tfsdk:"metric_type"`
+	Namespace  types.String                                    `tfsdk:"namespace"`
+}
+
+type serviceGroupModel struct {
+	GroupIdentifier types.String `tfsdk:"group_identifier"`
+	GroupName       types.String `tfsdk:"group_name"`
+	GroupSource     types.String `tfsdk:"group_source"`
+	GroupValue      types.String `tfsdk:"group_value"`
+}
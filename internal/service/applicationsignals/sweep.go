@@ -0,0 +1,42 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package applicationsignals
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/applicationsignals"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func RegisterSweepers() {
+	awsv2.Register("aws_applicationsignals_service_level_objective", sweepServiceLevelObjectives)
+}
+
+func sweepServiceLevelObjectives(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.ApplicationSignalsClient(ctx)
+	var input applicationsignals.ListServiceLevelObjectivesInput
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := applicationsignals.NewListServiceLevelObjectivesPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.SloSummaries {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newServiceLevelObjectiveResource, client,
+				framework.NewAttribute(names.AttrName, aws.ToString(v.Name))))
+		}
+	}
+
+	return sweepResources, nil
+}
@@ -0,0 +1,10 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_applicationsignals_grouping_configuration" "test" {
+  grouping_attribute_definition {
+    grouping_name        = "Team"
+    grouping_source_keys = ["team"]
+  }
+}
+
@@ -0,0 +1,149 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package arczonalshift
+
+import (
+	"context"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/service/arczonalshift"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/arczonalshift/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_arczonalshift_autoshift_observer_notification_status", name="Autoshift Observer Notification Status")
+// @SingletonIdentity(identityDuplicateAttributes="id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/arczonalshift;arczonalshift.GetAutoshiftObserverNotificationStatusOutput")
+// @Testing(generator=false)
+// @Testing(serialize=true)
+// @Testing(hasNoPreExistingResource=true)
+func newAutoshiftObserverNotificationStatusResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &autoshiftObserverNotificationStatusResource{}, nil
+}
+
+type autoshiftObserverNotificationStatusResource struct {
+	framework.ResourceWithModel[autoshiftObserverNotificationStatusResourceModel]
+	framework.WithImportByIdentity
+}
+
+func (r *autoshiftObserverNotificationStatusResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttributeDeprecatedWithAlternate(path.Root(names.AttrRegion)),
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.AutoshiftObserverNotificationStatus](),
+				Required:   true,
+			},
+		},
+	}
+}
+
+func (r *autoshiftObserverNotificationStatusResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan autoshiftObserverNotificationStatusResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &plan))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ARCZonalShiftClient(ctx)
+
+	if err := updateAutoshiftObserverNotificationStatus(ctx, conn, plan.Status.ValueEnum()); err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err)
+		return
+	}
+
+	// The notification status is applied per Region, so use the Region as the ID.
+	plan.ID = fwflex.StringValueToFramework(ctx, r.Meta().Region(ctx))
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, plan))
+}
+
+func (r *autoshiftObserverNotificationStatusResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state autoshiftObserverNotificationStatusResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &state))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ARCZonalShiftClient(ctx)
+
+	output, err := findAutoshiftObserverNotificationStatus(ctx, conn)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &state))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &state))
+}
+
+func (r *autoshiftObserverNotificationStatusResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan autoshiftObserverNotificationStatusResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &plan))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ARCZonalShiftClient(ctx)
+
+	if err := updateAutoshiftObserverNotificationStatus(ctx, conn, plan.Status.ValueEnum()); err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &plan))
+}
+
+func (r *autoshiftObserverNotificationStatusResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	conn := r.Meta().ARCZonalShiftClient(ctx)
+
+	// Deleting the resource disables notifications.
+	if err := updateAutoshiftObserverNotificationStatus(ctx, conn, awstypes.AutoshiftObserverNotificationStatusDisabled); err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err)
+		return
+	}
+}
+
+func updateAutoshiftObserverNotificationStatus(ctx context.Context, conn *arczonalshift.Client, status awstypes.AutoshiftObserverNotificationStatus) error {
+	input := arczonalshift.UpdateAutoshiftObserverNotificationStatusInput{
+		Status: status,
+	}
+	_, err := conn.UpdateAutoshiftObserverNotificationStatus(ctx, &input)
+
+	return err
+}
+
+func findAutoshiftObserverNotificationStatus(ctx context.Context, conn *arczonalshift.Client) (*arczonalshift.GetAutoshiftObserverNotificationStatusOutput, error) {
+	var input arczonalshift.GetAutoshiftObserverNotificationStatusInput
+	output, err := conn.GetAutoshiftObserverNotificationStatus(ctx, &input)
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if output == nil {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError())
+	}
+
+	return output, nil
+}
+
+type autoshiftObserverNotificationStatusResourceModel struct {
+	framework.WithRegionModel
+	ID     types.String                                                     `tfsdk:"id"`
+	Status fwtypes.StringEnum[awstypes.AutoshiftObserverNotificationStatus] `tfsdk:"status"`
+}
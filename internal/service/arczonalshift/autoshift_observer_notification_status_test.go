@@ -0,0 +1,122 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package arczonalshift_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/arczonalshift"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/arczonalshift/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfarczonalshift "github.com/hashicorp/terraform-provider-aws/internal/service/arczonalshift"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccARCZonalShiftAutoshiftObserverNotificationStatus_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]func(t *testing.T){
+		acctest.CtBasic: testAccAutoshiftObserverNotificationStatus_basic,
+		"Identity":      testAccARCZonalShiftAutoshiftObserverNotificationStatus_IdentitySerial,
+	}
+
+	acctest.RunSerialTests1Level(t, testCases, 0)
+}
+
+func testAccAutoshiftObserverNotificationStatus_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v arczonalshift.GetAutoshiftObserverNotificationStatusOutput
+	resourceName := "aws_arczonalshift_autoshift_observer_notification_status.test"
+
+	acctest.Test(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ARCZonalShiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAutoshiftObserverNotificationStatusDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutoshiftObserverNotificationStatusConfig_basic("ENABLED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAutoshiftObserverNotificationStatusExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "ENABLED"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAutoshiftObserverNotificationStatusConfig_basic("DISABLED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAutoshiftObserverNotificationStatusExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "DISABLED"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckAutoshiftObserverNotificationStatusDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).ARCZonalShiftClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_arczonalshift_autoshift_observer_notification_status" {
+				continue
+			}
+
+			output, err := tfarczonalshift.FindAutoshiftObserverNotificationStatus(ctx, conn)
+
+			if err != nil {
+				return err
+			}
+
+			if output.Status != awstypes.AutoshiftObserverNotificationStatusDisabled {
+				return fmt.Errorf("ARC Zonal Shift Autoshift Observer Notification Status is still %s", output.Status)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAutoshiftObserverNotificationStatusExists(ctx context.Context, t *testing.T, n string, v *arczonalshift.GetAutoshiftObserverNotificationStatusOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).ARCZonalShiftClient(ctx)
+
+		output, err := tfarczonalshift.FindAutoshiftObserverNotificationStatus(ctx, conn)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAutoshiftObserverNotificationStatusConfig_basic(status string) string {
+	return fmt.Sprintf(`
+resource "aws_arczonalshift_autoshift_observer_notification_status" "test" {
+  status = %[1]q
+}
+`, status)
+}
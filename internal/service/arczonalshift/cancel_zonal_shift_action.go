@@ -0,0 +1,152 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package arczonalshift
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/arczonalshift"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/arczonalshift/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+)
+
+// @Action(aws_arczonalshift_cancel_zonal_shift, name="Cancel Zonal Shift")
+func newCancelZonalShiftAction(context.Context) (action.ActionWithConfigure, error) {
+	return &cancelZonalShiftAction{}, nil
+}
+
+var (
+	_ action.Action = (*cancelZonalShiftAction)(nil)
+)
+
+type cancelZonalShiftAction struct {
+	framework.ActionWithModel[cancelZonalShiftActionModel]
+}
+
+type cancelZonalShiftActionModel struct {
+	framework.WithRegionModel
+	ResourceIdentifier types.String `tfsdk:"resource_identifier"`
+	ZonalShiftID       types.String `tfsdk:"zonal_shift_id"`
+}
+
+func (a *cancelZonalShiftAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Cancels a zonal shift, or every active zonal shift for a managed resource, so that traffic returns to all Availability Zones.",
+		Attributes: map[string]schema.Attribute{
+			"resource_identifier": schema.StringAttribute{
+				Description: "ARN of the managed resource whose active zonal shifts are canceled",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(
+						path.MatchRoot("resource_identifier"),
+						path.MatchRoot("zonal_shift_id"),
+					),
+				},
+			},
+			"zonal_shift_id": schema.StringAttribute{
+				Description: "ID of the zonal shift to cancel",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (a *cancelZonalShiftAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config cancelZonalShiftActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().ARCZonalShiftClient(ctx)
+
+	var zonalShiftIDs []string
+	if !config.ZonalShiftID.IsNull() {
+		zonalShiftIDs = append(zonalShiftIDs, config.ZonalShiftID.ValueString())
+	} else {
+		resourceIdentifier := config.ResourceIdentifier.ValueString()
+
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Listing active zonal shifts for %s...", resourceIdentifier),
+		})
+
+		shifts, err := findActiveZonalShiftsByResourceIdentifier(ctx, conn, resourceIdentifier)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("listing ARC zonal shifts for %s", resourceIdentifier), err.Error())
+			return
+		}
+
+		for _, v := range shifts {
+			zonalShiftIDs = append(zonalShiftIDs, aws.ToString(v.ZonalShiftId))
+		}
+
+		if len(zonalShiftIDs) == 0 {
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("No active zonal shifts found for %s", resourceIdentifier),
+			})
+			return
+		}
+	}
+
+	for _, zonalShiftID := range zonalShiftIDs {
+		tflog.Info(ctx, "Canceling ARC zonal shift", map[string]any{
+			"zonal_shift_id": zonalShiftID,
+		})
+
+		input := arczonalshift.CancelZonalShiftInput{
+			ZonalShiftId: aws.String(zonalShiftID),
+		}
+		output, err := conn.CancelZonalShift(ctx, &input)
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			resp.Diagnostics.AddError("Zonal Shift Not Found", fmt.Sprintf("ARC zonal shift %s was not found", zonalShiftID))
+			return
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("canceling ARC zonal shift %s", zonalShiftID), err.Error())
+			return
+		}
+
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Zonal shift %s away from %s for %s is %s", zonalShiftID, aws.ToString(output.AwayFrom), aws.ToString(output.ResourceIdentifier), output.Status),
+		})
+	}
+}
+
+// findActiveZonalShiftsByResourceIdentifier returns the customer-started zonal shifts that are active for the managed resource.
+// Practice runs and autoshifts are started by AWS and are excluded.
+func findActiveZonalShiftsByResourceIdentifier(ctx context.Context, conn *arczonalshift.Client, resourceIdentifier string) ([]awstypes.ZonalShiftSummary, error) {
+	input := arczonalshift.ListZonalShiftsInput{
+		ResourceIdentifier: aws.String(resourceIdentifier),
+		Status:             awstypes.ZonalShiftStatusActive,
+	}
+	var output []awstypes.ZonalShiftSummary
+
+	pages := arczonalshift.NewListZonalShiftsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Items {
+			if v.ShiftType != "" && v.ShiftType != awstypes.ShiftTypeZonalShift {
+				continue
+			}
+
+			output = append(output, v)
+		}
+	}
+
+	return output, nil
+}
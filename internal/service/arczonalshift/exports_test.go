@@ -0,0 +1,13 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package arczonalshift
+
+// Exports for use in tests only.
+var (
+	ResourceAutoshiftObserverNotificationStatus = newAutoshiftObserverNotificationStatusResource
+	ResourceZonalAutoshiftConfiguration         = newZonalAutoshiftConfigurationResource
+
+	FindAutoshiftObserverNotificationStatus      = findAutoshiftObserverNotificationStatus
+	FindZonalAutoshiftConfigurationByResourceARN = findZonalAutoshiftConfigurationByResourceARN
+)
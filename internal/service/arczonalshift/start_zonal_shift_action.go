@@ -0,0 +1,197 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package arczonalshift
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/arczonalshift"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/arczonalshift/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	startZonalShiftPollInterval   = 10 * time.Second
+	startZonalShiftDefaultTimeout = 5 * time.Minute
+)
+
+// @Action(aws_arczonalshift_start_zonal_shift, name="Start Zonal Shift")
+func newStartZonalShiftAction(context.Context) (action.ActionWithConfigure, error) {
+	return &startZonalShiftAction{}, nil
+}
+
+var (
+	_ action.Action = (*startZonalShiftAction)(nil)
+)
+
+type startZonalShiftAction struct {
+	framework.ActionWithModel[startZonalShiftActionModel]
+}
+
+type startZonalShiftActionModel struct {
+	framework.WithRegionModel
+	AwayFrom           types.String `tfsdk:"away_from"`
+	Comment            types.String `tfsdk:"comment"`
+	ExpiresIn          types.String `tfsdk:"expires_in"`
+	ResourceIdentifier types.String `tfsdk:"resource_identifier"`
+	Timeout            types.Int64  `tfsdk:"timeout" autoflex:"-"`
+}
+
+func (a *startZonalShiftAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts a zonal shift that moves traffic for a managed resource away from an Availability Zone, and waits for the shift to be applied.",
+		Attributes: map[string]schema.Attribute{
+			"away_from": schema.StringAttribute{
+				Description: "Availability Zone ID (for example, use1-az1) to move traffic away from",
+				Required:    true,
+			},
+			names.AttrComment: schema.StringAttribute{
+				Description: "Comment describing why the zonal shift was started",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(128),
+				},
+			},
+			"expires_in": schema.StringAttribute{
+				Description: "Length of time the zonal shift is active, in minutes (for example, 30m) or hours (for example, 2h)",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[1-9][0-9]*[mh]$`), "must be a number of minutes (m) or hours (h), for example 30m or 2h"),
+				},
+			},
+			"resource_identifier": schema.StringAttribute{
+				Description: "ARN of the managed resource to shift traffic for",
+				Required:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the zonal shift to be applied (default: 300)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(30),
+					int64validator.AtMost(3600),
+				},
+			},
+		},
+	}
+}
+
+func (a *startZonalShiftAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config startZonalShiftActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().ARCZonalShiftClient(ctx)
+
+	resourceIdentifier := config.ResourceIdentifier.ValueString()
+	awayFrom := config.AwayFrom.ValueString()
+
+	timeout := startZonalShiftDefaultTimeout
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting ARC zonal shift", map[string]any{
+		"resource_identifier": resourceIdentifier,
+		"away_from":           awayFrom,
+		names.AttrTimeout:     timeout.String(),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Starting zonal shift away from %s for %s...", awayFrom, resourceIdentifier),
+	})
+
+	var input arczonalshift.StartZonalShiftInput
+	resp.Diagnostics.Append(fwflex.Expand(ctx, config, &input)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.StartZonalShift(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("starting ARC zonal shift for %s", resourceIdentifier), err.Error())
+		return
+	}
+
+	zonalShiftID := aws.ToString(output.ZonalShiftId)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Zonal shift %s started (expires %s), waiting for it to be applied...", zonalShiftID, aws.ToTime(output.ExpiryTime).Format(time.RFC3339)),
+	})
+
+	_, err = actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[struct{}], error) {
+		status, err := findZonalShiftAppliedStatus(ctx, conn, resourceIdentifier, zonalShiftID)
+		if err != nil {
+			return actionwait.FetchResult[struct{}]{}, err
+		}
+		return actionwait.FetchResult[struct{}]{Status: actionwait.Status(status)}, nil
+	}, actionwait.Options[struct{}]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(startZonalShiftPollInterval),
+		ProgressInterval: 30 * time.Second,
+		SuccessStates:    []actionwait.Status{actionwait.Status(awstypes.AppliedStatusApplied)},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.AppliedStatusNotApplied),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Zonal shift %s is currently %s, continuing to wait for %s...", zonalShiftID, fr.Status, awstypes.AppliedStatusApplied),
+			})
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Zonal Shift",
+				fmt.Sprintf("ARC zonal shift %s for %s was not applied within %s. Another zonal shift or autoshift may take precedence.", zonalShiftID, resourceIdentifier, timeout),
+			)
+		} else {
+			resp.Diagnostics.AddError(fmt.Sprintf("waiting for ARC zonal shift %s to be applied", zonalShiftID), err.Error())
+		}
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Zonal shift %s is applied; traffic for %s has moved away from %s", zonalShiftID, resourceIdentifier, awayFrom),
+	})
+
+	tflog.Info(ctx, "ARC zonal shift applied", map[string]any{
+		"resource_identifier": resourceIdentifier,
+		"zonal_shift_id":      zonalShiftID,
+	})
+}
+
+// findZonalShiftAppliedStatus returns whether the specified zonal shift is currently applied to the managed resource.
+// A shift that isn't yet listed against the resource is reported as not applied.
+func findZonalShiftAppliedStatus(ctx context.Context, conn *arczonalshift.Client, resourceIdentifier, zonalShiftID string) (awstypes.AppliedStatus, error) {
+	output, err := findManagedResourceByID(ctx, conn, resourceIdentifier)
+	if err != nil {
+		return "", err
+	}
+
+	for _, v := range output.ZonalShifts {
+		if aws.ToString(v.ZonalShiftId) == zonalShiftID {
+			return v.AppliedStatus, nil
+		}
+	}
+
+	return awstypes.AppliedStatusNotApplied, nil
+}
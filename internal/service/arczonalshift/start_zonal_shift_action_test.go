@@ -0,0 +1,159 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package arczonalshift_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/arczonalshift"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/arczonalshift/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccARCZonalShiftStartZonalShiftAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ARCZonalShiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartZonalShiftActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckZonalShiftStatus(ctx, t, "aws_lb.test", awstypes.ZonalShiftStatusCanceled),
+				),
+			},
+		},
+	})
+}
+
+func TestAccARCZonalShiftStartZonalShiftAction_invalidExpiresIn(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ARCZonalShiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStartZonalShiftActionConfig_invalidExpiresIn(rName),
+				ExpectError: regexache.MustCompile(`must be a number of minutes \(m\) or hours \(h\)`),
+			},
+		},
+	})
+}
+
+// testAccCheckZonalShiftStatus verifies that the most recent zonal shift for the load balancer has the expected status.
+func testAccCheckZonalShiftStatus(ctx context.Context, t *testing.T, n string, want awstypes.ZonalShiftStatus) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).ARCZonalShiftClient(ctx)
+
+		input := arczonalshift.ListZonalShiftsInput{
+			ResourceIdentifier: aws.String(rs.Primary.Attributes[names.AttrARN]),
+			Status:             want,
+		}
+		output, err := conn.ListZonalShifts(ctx, &input)
+
+		if err != nil {
+			return err
+		}
+
+		if len(output.Items) == 0 {
+			return fmt.Errorf("no %s zonal shifts found for %s", want, rs.Primary.Attributes[names.AttrARN])
+		}
+
+		return nil
+	}
+}
+
+func testAccStartZonalShiftActionConfig_base(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 2), fmt.Sprintf(`
+resource "aws_lb" "test" {
+  name               = %[1]q
+  internal           = true
+  load_balancer_type = "network"
+  subnets            = aws_subnet.test[*].id
+}
+
+data "aws_subnet" "test" {
+  id = aws_subnet.test[0].id
+}
+`, rName))
+}
+
+func testAccStartZonalShiftActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccStartZonalShiftActionConfig_base(rName), `
+action "aws_arczonalshift_start_zonal_shift" "test" {
+  config {
+    resource_identifier = aws_lb.test.arn
+    away_from           = data.aws_subnet.test.availability_zone_id
+    comment             = "acceptance test"
+    expires_in          = "5m"
+  }
+}
+
+action "aws_arczonalshift_cancel_zonal_shift" "test" {
+  config {
+    resource_identifier = aws_lb.test.arn
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_lb.test.arn
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_arczonalshift_start_zonal_shift.test, action.aws_arczonalshift_cancel_zonal_shift.test]
+    }
+  }
+}
+`)
+}
+
+func testAccStartZonalShiftActionConfig_invalidExpiresIn(rName string) string {
+	return acctest.ConfigCompose(testAccStartZonalShiftActionConfig_base(rName), `
+action "aws_arczonalshift_start_zonal_shift" "test" {
+  config {
+    resource_identifier = aws_lb.test.arn
+    away_from           = data.aws_subnet.test.availability_zone_id
+    comment             = "acceptance test"
+    expires_in          = "5d"
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_lb.test.arn
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_arczonalshift_start_zonal_shift.test]
+    }
+  }
+}
+`)
+}
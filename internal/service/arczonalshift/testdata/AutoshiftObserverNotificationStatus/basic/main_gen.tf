@@ -0,0 +1,7 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_arczonalshift_autoshift_observer_notification_status" "test" {
+  status = "ENABLED"
+}
+
@@ -0,0 +1,330 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package arczonalshift
+
+import (
+	"context"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/arczonalshift"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/arczonalshift/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_arczonalshift_zonal_autoshift_configuration", name="Zonal Autoshift Configuration")
+// @ArnIdentity("resource_arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/arczonalshift;arczonalshift.GetManagedResourceOutput")
+// @Testing(hasNoPreExistingResource=true)
+func newZonalAutoshiftConfigurationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &zonalAutoshiftConfigurationResource{}, nil
+}
+
+type zonalAutoshiftConfigurationResource struct {
+	framework.ResourceWithModel[zonalAutoshiftConfigurationResourceModel]
+	framework.WithImportByIdentity
+}
+
+func (r *zonalAutoshiftConfigurationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	windowsAttribute := func() schema.SetAttribute {
+		return schema.SetAttribute{
+			CustomType:  fwtypes.SetOfStringType,
+			ElementType: types.StringType,
+			Optional:    true,
+			Validators: []validator.Set{
+				setvalidator.SizeAtMost(15),
+			},
+		}
+	}
+	controlConditionBlock := func(validators ...validator.List) schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[controlConditionModel](ctx),
+			Validators: append([]validator.List{
+				listvalidator.SizeAtMost(1),
+			}, validators...),
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"alarm_identifier": schema.StringAttribute{
+						CustomType: fwtypes.ARNType,
+						Required:   true,
+					},
+					names.AttrType: schema.StringAttribute{
+						CustomType: fwtypes.StringEnumType[awstypes.ControlConditionType](),
+						Required:   true,
+					},
+				},
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"allowed_windows": windowsAttribute(),
+			"blocked_dates":   windowsAttribute(),
+			"blocked_windows": windowsAttribute(),
+			names.AttrResourceARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"zonal_autoshift_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ZonalAutoshiftStatus](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"blocking_alarm": controlConditionBlock(),
+			"outcome_alarm":  controlConditionBlock(listvalidator.IsRequired()),
+		},
+	}
+}
+
+func (r *zonalAutoshiftConfigurationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan zonalAutoshiftConfigurationResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &plan))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ARCZonalShiftClient(ctx)
+
+	resourceARN := fwflex.StringValueFromFramework(ctx, plan.ResourceARN)
+	var input arczonalshift.CreatePracticeRunConfigurationInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, plan, &input))
+	if response.Diagnostics.HasError() {
+		return
+	}
+	input.ResourceIdentifier = aws.String(resourceARN)
+
+	output, err := conn.CreatePracticeRunConfiguration(ctx, &input)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, resourceARN)
+		return
+	}
+
+	// Zonal autoshift can only be enabled once a practice run configuration exists.
+	if status := plan.ZonalAutoshiftStatus.ValueEnum(); status != "" && status != output.ZonalAutoshiftStatus {
+		if err := updateZonalAutoshiftStatus(ctx, conn, resourceARN, status); err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, resourceARN)
+			return
+		}
+	} else {
+		plan.ZonalAutoshiftStatus = fwtypes.StringEnumValue(output.ZonalAutoshiftStatus)
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, plan))
+}
+
+func (r *zonalAutoshiftConfigurationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state zonalAutoshiftConfigurationResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &state))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ARCZonalShiftClient(ctx)
+
+	resourceARN := fwflex.StringValueFromFramework(ctx, state.ResourceARN)
+	output, err := findZonalAutoshiftConfigurationByResourceARN(ctx, conn, resourceARN)
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, resourceARN)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output.PracticeRunConfiguration, &state))
+	if response.Diagnostics.HasError() {
+		return
+	}
+	state.ZonalAutoshiftStatus = fwtypes.StringEnumValue(output.ZonalAutoshiftStatus)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &state))
+}
+
+func (r *zonalAutoshiftConfigurationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan, state zonalAutoshiftConfigurationResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &plan))
+	if response.Diagnostics.HasError() {
+		return
+	}
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &state))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ARCZonalShiftClient(ctx)
+
+	resourceARN := fwflex.StringValueFromFramework(ctx, plan.ResourceARN)
+
+	diff, d := fwflex.Diff(ctx, plan, state, fwflex.WithIgnoredField("ZonalAutoshiftStatus"))
+	smerr.AddEnrich(ctx, &response.Diagnostics, d)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		var input arczonalshift.UpdatePracticeRunConfigurationInput
+		smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, plan, &input))
+		if response.Diagnostics.HasError() {
+			return
+		}
+		input.ResourceIdentifier = aws.String(resourceARN)
+
+		// Omitted values are left unchanged, so send empty values to clear them.
+		if input.AllowedWindows == nil {
+			input.AllowedWindows = []string{}
+		}
+		if input.BlockedDates == nil {
+			input.BlockedDates = []string{}
+		}
+		if input.BlockedWindows == nil {
+			input.BlockedWindows = []string{}
+		}
+		if input.BlockingAlarms == nil {
+			input.BlockingAlarms = []awstypes.ControlCondition{}
+		}
+
+		_, err := conn.UpdatePracticeRunConfiguration(ctx, &input)
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, resourceARN)
+			return
+		}
+	}
+
+	if status := plan.ZonalAutoshiftStatus.ValueEnum(); status != "" && !plan.ZonalAutoshiftStatus.Equal(state.ZonalAutoshiftStatus) {
+		if err := updateZonalAutoshiftStatus(ctx, conn, resourceARN, status); err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, resourceARN)
+			return
+		}
+	} else {
+		plan.ZonalAutoshiftStatus = state.ZonalAutoshiftStatus
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &plan))
+}
+
+func (r *zonalAutoshiftConfigurationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var state zonalAutoshiftConfigurationResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &state))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ARCZonalShiftClient(ctx)
+
+	resourceARN := fwflex.StringValueFromFramework(ctx, state.ResourceARN)
+
+	// The practice run configuration can't be deleted while zonal autoshift is enabled.
+	if state.ZonalAutoshiftStatus.ValueEnum() == awstypes.ZonalAutoshiftStatusEnabled {
+		err := updateZonalAutoshiftStatus(ctx, conn, resourceARN, awstypes.ZonalAutoshiftStatusDisabled)
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return
+		}
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, resourceARN)
+			return
+		}
+	}
+
+	input := arczonalshift.DeletePracticeRunConfigurationInput{
+		ResourceIdentifier: aws.String(resourceARN),
+	}
+	_, err := conn.DeletePracticeRunConfiguration(ctx, &input)
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, resourceARN)
+		return
+	}
+}
+
+func updateZonalAutoshiftStatus(ctx context.Context, conn *arczonalshift.Client, resourceARN string, status awstypes.ZonalAutoshiftStatus) error {
+	input := arczonalshift.UpdateZonalAutoshiftConfigurationInput{
+		ResourceIdentifier:   aws.String(resourceARN),
+		ZonalAutoshiftStatus: status,
+	}
+	_, err := conn.UpdateZonalAutoshiftConfiguration(ctx, &input)
+
+	return err
+}
+
+func findManagedResourceByID(ctx context.Context, conn *arczonalshift.Client, id string) (*arczonalshift.GetManagedResourceOutput, error) {
+	input := arczonalshift.GetManagedResourceInput{
+		ResourceIdentifier: aws.String(id),
+	}
+	output, err := conn.GetManagedResource(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			LastError: err,
+		})
+	}
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if output == nil {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError())
+	}
+
+	return output, nil
+}
+
+func findZonalAutoshiftConfigurationByResourceARN(ctx context.Context, conn *arczonalshift.Client, resourceARN string) (*arczonalshift.GetManagedResourceOutput, error) {
+	output, err := findManagedResourceByID(ctx, conn, resourceARN)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output.PracticeRunConfiguration == nil {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError())
+	}
+
+	return output, nil
+}
+
+type zonalAutoshiftConfigurationResourceModel struct {
+	framework.WithRegionModel
+	AllowedWindows       fwtypes.SetOfString                                    `tfsdk:"allowed_windows"`
+	BlockedDates         fwtypes.SetOfString                                    `tfsdk:"blocked_dates"`
+	BlockedWindows       fwtypes.SetOfString                                    `tfsdk:"blocked_windows"`
+	BlockingAlarms       fwtypes.ListNestedObjectValueOf[controlConditionModel] `tfsdk:"blocking_alarm"`
+	OutcomeAlarms        fwtypes.ListNestedObjectValueOf[controlConditionModel] `tfsdk:"outcome_alarm"`
+	ResourceARN          fwtypes.ARN                                            `tfsdk:"resource_arn"`
+	ZonalAutoshiftStatus fwtypes.StringEnum[awstypes.ZonalAutoshiftStatus]      `tfsdk:"zonal_autoshift_status" autoflex:"-"`
+}
+
+type controlConditionModel struct {
+	AlarmIdentifier fwtypes.ARN                                       `tfsdk:"alarm_identifier"`
+	Type            fwtypes.StringEnum[awstypes.ControlConditionType] `tfsdk:"type"`
+}
@@ -0,0 +1,265 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package arczonalshift_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/arczonalshift"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfarczonalshift "github.com/hashicorp/terraform-provider-aws/internal/service/arczonalshift"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccARCZonalShiftZonalAutoshiftConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v arczonalshift.GetManagedResourceOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_arczonalshift_zonal_autoshift_configuration.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ARCZonalShiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckZonalAutoshiftConfigurationDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZonalAutoshiftConfigurationConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckZonalAutoshiftConfigurationExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "blocking_alarm.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "outcome_alarm.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "outcome_alarm.0.alarm_identifier", "aws_cloudwatch_metric_alarm.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "outcome_alarm.0.type", "CLOUDWATCH"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrResourceARN, "aws_lb.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "zonal_autoshift_status", "DISABLED"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrResourceARN),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrResourceARN,
+			},
+		},
+	})
+}
+
+func TestAccARCZonalShiftZonalAutoshiftConfiguration_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v arczonalshift.GetManagedResourceOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_arczonalshift_zonal_autoshift_configuration.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ARCZonalShiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckZonalAutoshiftConfigurationDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZonalAutoshiftConfigurationConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckZonalAutoshiftConfigurationExists(ctx, t, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, t, tfarczonalshift.ResourceZonalAutoshiftConfiguration, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccARCZonalShiftZonalAutoshiftConfiguration_full(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v arczonalshift.GetManagedResourceOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_arczonalshift_zonal_autoshift_configuration.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ARCZonalShiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckZonalAutoshiftConfigurationDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZonalAutoshiftConfigurationConfig_full(rName, "ENABLED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckZonalAutoshiftConfigurationExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "blocked_dates.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "blocked_dates.*", "2030-12-25"),
+					resource.TestCheckResourceAttr(resourceName, "blocked_windows.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "blocked_windows.*", "Mon:00:00-Mon:08:00"),
+					resource.TestCheckResourceAttr(resourceName, "blocking_alarm.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "blocking_alarm.0.alarm_identifier", "aws_cloudwatch_metric_alarm.blocking", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "zonal_autoshift_status", "ENABLED"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrResourceARN),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrResourceARN,
+			},
+			{
+				Config: testAccZonalAutoshiftConfigurationConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckZonalAutoshiftConfigurationExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "blocked_dates.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "blocked_windows.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "blocking_alarm.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "zonal_autoshift_status", "ENABLED"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+			{
+				Config: testAccZonalAutoshiftConfigurationConfig_full(rName, "DISABLED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckZonalAutoshiftConfigurationExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "blocking_alarm.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "zonal_autoshift_status", "DISABLED"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckZonalAutoshiftConfigurationDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).ARCZonalShiftClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_arczonalshift_zonal_autoshift_configuration" {
+				continue
+			}
+
+			_, err := tfarczonalshift.FindZonalAutoshiftConfigurationByResourceARN(ctx, conn, rs.Primary.Attributes[names.AttrResourceARN])
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("ARC Zonal Shift Zonal Autoshift Configuration %s still exists", rs.Primary.Attributes[names.AttrResourceARN])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckZonalAutoshiftConfigurationExists(ctx context.Context, t *testing.T, n string, v *arczonalshift.GetManagedResourceOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).ARCZonalShiftClient(ctx)
+
+		output, err := tfarczonalshift.FindZonalAutoshiftConfigurationByResourceARN(ctx, conn, rs.Primary.Attributes[names.AttrResourceARN])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccZonalAutoshiftConfigurationConfig_base(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 2), fmt.Sprintf(`
+resource "aws_lb" "test" {
+  name               = %[1]q
+  internal           = true
+  load_balancer_type = "network"
+  subnets            = aws_subnet.test[*].id
+}
+
+resource "aws_cloudwatch_metric_alarm" "test" {
+  alarm_name          = %[1]q
+  comparison_operator = "GreaterThanOrEqualToThreshold"
+  evaluation_periods  = 1
+  metric_name         = "UnHealthyHostCount"
+  namespace           = "AWS/NetworkELB"
+  period              = 60
+  statistic           = "Maximum"
+  threshold           = 1
+
+  dimensions = {
+    LoadBalancer = aws_lb.test.arn_suffix
+  }
+}
+
+resource "aws_cloudwatch_metric_alarm" "blocking" {
+  alarm_name          = "%[1]s-blocking"
+  comparison_operator = "GreaterThanOrEqualToThreshold"
+  evaluation_periods  = 1
+  metric_name         = "TCP_ELB_Reset_Count"
+  namespace           = "AWS/NetworkELB"
+  period              = 60
+  statistic           = "Sum"
+  threshold           = 1000
+
+  dimensions = {
+    LoadBalancer = aws_lb.test.arn_suffix
+  }
+}
+`, rName))
+}
+
+func testAccZonalAutoshiftConfigurationConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccZonalAutoshiftConfigurationConfig_base(rName), `
+resource "aws_arczonalshift_zonal_autoshift_configuration" "test" {
+  resource_arn = aws_lb.test.arn
+
+  outcome_alarm {
+    alarm_identifier = aws_cloudwatch_metric_alarm.test.arn
+    type             = "CLOUDWATCH"
+  }
+}
+`)
+}
+
+func testAccZonalAutoshiftConfigurationConfig_full(rName, status string) string {
+	return acctest.ConfigCompose(testAccZonalAutoshiftConfigurationConfig_base(rName), fmt.Sprintf(`
+resource "aws_arczonalshift_zonal_autoshift_configuration" "test" {
+  resource_arn           = aws_lb.test.arn
+  zonal_autoshift_status = %[1]q
+
+  blocked_dates   = ["2030-12-25"]
+  blocked_windows = ["Mon:00:00-Mon:08:00"]
+
+  blocking_alarm {
+    alarm_identifier = aws_cloudwatch_metric_alarm.blocking.arn
+    type             = "CLOUDWATCH"
+  }
+
+  outcome_alarm {
+    alarm_identifier = aws_cloudwatch_metric_alarm.test.arn
+    type             = "CLOUDWATCH"
+  }
+}
+`, status))
+}
@@ -0,0 +1,281 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package athena
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/athena"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/athena/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	queryResultsDefaultMaxRows = 1000
+	queryResultsTimeout        = 10 * time.Minute
+)
+
+// selectQueryPattern matches queries whose first keyword, ignoring leading comments and parentheses, is SELECT, WITH or VALUES.
+// The statement type is only known once a query has run, so other statements (e.g. DDL or INSERT INTO) are rejected before they are started.
+var selectQueryPattern = regexache.MustCompile(`^(?i)(?:\s|--[^\n]*\n|/\*(?:[^*]|\*+[^*/])*\*+/|\()*(?:SELECT|WITH|VALUES)\b`)
+
+const selectQueryMessage = "must be a SELECT query"
+
+// @FrameworkDataSource("aws_athena_query_results", name="Query Results")
+func newQueryResultsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &queryResultsDataSource{}, nil
+}
+
+type queryResultsDataSource struct {
+	framework.DataSourceWithModel[queryResultsDataSourceModel]
+}
+
+func (d *queryResultsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"catalog": schema.StringAttribute{
+				Optional: true,
+			},
+			"columns": schema.ListAttribute{
+				CustomType: fwtypes.ListOfStringType,
+				Computed:   true,
+			},
+			names.AttrDatabase: schema.StringAttribute{
+				Optional: true,
+			},
+			"execution_parameters": schema.ListAttribute{
+				CustomType: fwtypes.ListOfStringType,
+				Optional:   true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"max_rows": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 10000),
+				},
+			},
+			"output_location": schema.StringAttribute{
+				Optional: true,
+			},
+			"query_execution_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"query_string": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(selectQueryPattern, selectQueryMessage),
+				},
+			},
+			"rows": schema.ListAttribute{
+				ElementType: types.MapType{ElemType: types.StringType},
+				Computed:    true,
+			},
+			"workgroup": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (d *queryResultsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data queryResultsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().AthenaClient(ctx)
+
+	maxRows := int64(queryResultsDefaultMaxRows)
+	if !data.MaxRows.IsNull() {
+		maxRows = data.MaxRows.ValueInt64()
+	}
+
+	// The query string may not have been known when the configuration was validated.
+	if !selectQueryPattern.MatchString(data.QueryString.ValueString()) {
+		response.Diagnostics.AddAttributeError(path.Root("query_string"), "Invalid Attribute Value", "Attribute query_string "+selectQueryMessage)
+		return
+	}
+
+	input := athena.StartQueryExecutionInput{
+		ExecutionParameters: fwflex.ExpandFrameworkStringValueList(ctx, data.ExecutionParameters),
+		QueryString:         data.QueryString.ValueStringPointer(),
+		WorkGroup:           data.WorkGroup.ValueStringPointer(),
+	}
+	if !data.Catalog.IsNull() || !data.Database.IsNull() {
+		input.QueryExecutionContext = &awstypes.QueryExecutionContext{
+			Catalog:  data.Catalog.ValueStringPointer(),
+			Database: data.Database.ValueStringPointer(),
+		}
+	}
+	if !data.OutputLocation.IsNull() {
+		input.ResultConfiguration = &awstypes.ResultConfiguration{
+			OutputLocation: data.OutputLocation.ValueStringPointer(),
+		}
+	}
+
+	output, err := conn.StartQueryExecution(ctx, &input)
+	if err != nil {
+		response.Diagnostics.AddError("starting Athena query execution", err.Error())
+		return
+	}
+
+	queryExecutionID := aws.ToString(output.QueryExecutionId)
+
+	queryExecution, err := waitQueryExecutionSucceeded(ctx, conn, queryExecutionID, queryResultsTimeout)
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Athena query execution (%s)", queryExecutionID), err.Error())
+		return
+	}
+
+	if queryExecution.StatementType != awstypes.StatementTypeDml {
+		response.Diagnostics.AddError(
+			fmt.Sprintf("reading Athena query results (%s)", queryExecutionID),
+			fmt.Sprintf("only queries that return rows are supported, got statement type %s", queryExecution.StatementType),
+		)
+		return
+	}
+
+	columns, rows, err := findQueryResultsByID(ctx, conn, queryExecutionID, maxRows)
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Athena query results (%s)", queryExecutionID), err.Error())
+		return
+	}
+
+	data.Columns = fwflex.FlattenFrameworkStringValueListOfString(ctx, columns)
+	data.QueryExecutionID = types.StringValue(queryExecutionID)
+	rowsValue, diags := types.ListValueFrom(ctx, types.MapType{ElemType: types.StringType}, rows)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.Rows = rowsValue
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// findQueryResultsByID returns the column names and rows of a SELECT query's results.
+// An error is returned if the results contain more than maxRows rows.
+func findQueryResultsByID(ctx context.Context, conn *athena.Client, id string, maxRows int64) ([]string, []map[string]types.String, error) {
+	input := athena.GetQueryResultsInput{
+		QueryExecutionId: aws.String(id),
+	}
+
+	var columns []string
+	var rows []map[string]types.String
+	header := true
+	pages := athena.NewGetQueryResultsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if page.ResultSet == nil {
+			continue
+		}
+
+		if columns == nil && page.ResultSet.ResultSetMetadata != nil {
+			for _, v := range page.ResultSet.ResultSetMetadata.ColumnInfo {
+				columns = append(columns, aws.ToString(v.Name))
+			}
+		}
+
+		for _, row := range page.ResultSet.Rows {
+			// The first row of a SELECT query's results contains the column names.
+			if header {
+				header = false
+				continue
+			}
+
+			if int64(len(rows)) >= maxRows {
+				return nil, nil, fmt.Errorf("query returned more than %d rows, increase max_rows or add a LIMIT clause", maxRows)
+			}
+
+			tfMap := make(map[string]types.String, len(columns))
+			for i, datum := range row.Data {
+				if i >= len(columns) {
+					break
+				}
+				tfMap[columns[i]] = types.StringPointerValue(datum.VarCharValue)
+			}
+			rows = append(rows, tfMap)
+		}
+	}
+
+	if rows == nil {
+		rows = []map[string]types.String{}
+	}
+
+	return columns, rows, nil
+}
+
+func waitQueryExecutionSucceeded(ctx context.Context, conn *athena.Client, id string, timeout time.Duration) (*awstypes.QueryExecution, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.QueryExecutionStateQueued, awstypes.QueryExecutionStateRunning),
+		Target:     enum.Slice(awstypes.QueryExecutionStateSucceeded),
+		Refresh:    statusQueryExecution(conn, id),
+		Timeout:    timeout,
+		MinTimeout: 2 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if output, ok := outputRaw.(*awstypes.QueryExecution); ok {
+		if state := output.Status.State; state == awstypes.QueryExecutionStateFailed || state == awstypes.QueryExecutionStateCancelled {
+			retry.SetLastError(err, fmt.Errorf("%s", queryExecutionFailureDetail(output)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func statusQueryExecution(conn *athena.Client, id string) retry.StateRefreshFunc {
+	return func(ctx context.Context) (any, string, error) {
+		output, err := findQueryExecutionByID(ctx, conn, id)
+		if retry.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status.State), nil
+	}
+}
+
+type queryResultsDataSourceModel struct {
+	framework.WithRegionModel
+	Catalog             types.String         `tfsdk:"catalog"`
+	Columns             fwtypes.ListOfString `tfsdk:"columns"`
+	Database            types.String         `tfsdk:"database"`
+	ExecutionParameters fwtypes.ListOfString `tfsdk:"execution_parameters"`
+	MaxRows             types.Int64          `tfsdk:"max_rows"`
+	OutputLocation      types.String         `tfsdk:"output_location"`
+	QueryExecutionID    types.String         `tfsdk:"query_execution_id"`
+	QueryString         types.String         `tfsdk:"query_string"`
+	Rows                types.List           `tfsdk:"rows"`
+	WorkGroup           types.String         `tfsdk:"workgroup"`
+}
@@ -0,0 +1,102 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package athena_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAthenaQueryResultsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	dataSourceName := "data.aws_athena_query_results.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AthenaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueryResultsDataSourceConfig_basic(rName, 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "columns.#", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "columns.0", names.AttrID),
+					resource.TestCheckResourceAttr(dataSourceName, "columns.1", names.AttrName),
+					resource.TestCheckResourceAttr(dataSourceName, "columns.2", "nothing"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "query_execution_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "rows.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "rows.0.id", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "rows.0.name", "first"),
+					resource.TestCheckResourceAttr(dataSourceName, "rows.1.id", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "rows.1.name", "second"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAthenaQueryResultsDataSource_maxRows(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AthenaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccQueryResultsDataSourceConfig_basic(rName, 1),
+				ExpectError: regexache.MustCompile(`query returned more than 1 rows`),
+			},
+		},
+	})
+}
+
+func TestAccAthenaQueryResultsDataSource_nonSelectQuery(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AthenaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccQueryResultsDataSourceConfig_queryString(rName, "-- SELECT\nDROP TABLE IF EXISTS test"),
+				ExpectError: regexache.MustCompile(`must be a SELECT query`),
+			},
+			{
+				Config:      testAccQueryResultsDataSourceConfig_queryString(rName, "INSERT INTO test VALUES (1)"),
+				ExpectError: regexache.MustCompile(`must be a SELECT query`),
+			},
+		},
+	})
+}
+
+func testAccQueryResultsDataSourceConfig_basic(rName string, maxRows int) string {
+	return acctest.ConfigCompose(testAccStartQueryExecutionActionConfig_base(rName), fmt.Sprintf(`
+data "aws_athena_query_results" "test" {
+  workgroup    = aws_athena_workgroup.test.name
+  database     = aws_athena_database.test.name
+  query_string = "SELECT id, name, CAST(NULL AS VARCHAR) AS nothing FROM (VALUES (1, 'first'), (2, 'second')) AS t (id, name) ORDER BY id"
+  max_rows     = %[1]d
+}
+`, maxRows))
+}
+
+func testAccQueryResultsDataSourceConfig_queryString(rName, queryString string) string {
+	return acctest.ConfigCompose(testAccStartQueryExecutionActionConfig_base(rName), fmt.Sprintf(`
+data "aws_athena_query_results" "test" {
+  workgroup    = aws_athena_workgroup.test.name
+  database     = aws_athena_database.test.name
+  query_string = %[1]q
+}
+`, queryString))
+}
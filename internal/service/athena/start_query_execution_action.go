@@ -0,0 +1,261 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package athena
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/athena"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/athena/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	startQueryExecutionPollInterval   = 5 * time.Second
+	startQueryExecutionDefaultTimeout = 30 * time.Minute
+)
+
+// @Action(aws_athena_start_query_execution, name="Start Query Execution")
+func newStartQueryExecutionAction(context.Context) (action.ActionWithConfigure, error) {
+	return &startQueryExecutionAction{}, nil
+}
+
+var (
+	_ action.Action = (*startQueryExecutionAction)(nil)
+)
+
+type startQueryExecutionAction struct {
+	framework.ActionWithModel[startQueryExecutionActionModel]
+}
+
+type startQueryExecutionActionModel struct {
+	framework.WithRegionModel
+	Catalog             types.String         `tfsdk:"catalog"`
+	Database            types.String         `tfsdk:"database"`
+	ExecutionParameters fwtypes.ListOfString `tfsdk:"execution_parameters"`
+	OutputLocation      types.String         `tfsdk:"output_location"`
+	QueryString         types.String         `tfsdk:"query_string"`
+	Timeout             types.Int64          `tfsdk:"timeout"`
+	WorkGroup           types.String         `tfsdk:"workgroup"`
+}
+
+func (a *startQueryExecutionAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs an Athena query and waits for it to complete.",
+		Attributes: map[string]schema.Attribute{
+			"catalog": schema.StringAttribute{
+				Description: "Name of the data catalog used in the query execution",
+				Optional:    true,
+			},
+			names.AttrDatabase: schema.StringAttribute{
+				Description: "Name of the database used in the query execution",
+				Optional:    true,
+			},
+			"execution_parameters": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				Description: "Values for the ? parameters in the query, in order",
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"output_location": schema.StringAttribute{
+				Description: "S3 path where query results are stored. Required unless the workgroup specifies an output location or uses managed query results",
+				Optional:    true,
+			},
+			"query_string": schema.StringAttribute{
+				Description: "SQL query to run",
+				Required:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the query to complete (default: 1800)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(10),
+					int64validator.AtMost(86400),
+				},
+			},
+			"workgroup": schema.StringAttribute{
+				Description: "Name of the workgroup in which the query runs (default: primary)",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (a *startQueryExecutionAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config startQueryExecutionActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().AthenaClient(ctx)
+
+	timeout := startQueryExecutionDefaultTimeout
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting Athena query execution", map[string]any{
+		"workgroup":        config.WorkGroup.ValueString(),
+		names.AttrDatabase: config.Database.ValueString(),
+		names.AttrTimeout:  timeout.String(),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: "Starting Athena query execution...",
+	})
+
+	input := athena.StartQueryExecutionInput{
+		ExecutionParameters: fwflex.ExpandFrameworkStringValueList(ctx, config.ExecutionParameters),
+		QueryString:         config.QueryString.ValueStringPointer(),
+		WorkGroup:           config.WorkGroup.ValueStringPointer(),
+	}
+	if !config.Catalog.IsNull() || !config.Database.IsNull() {
+		input.QueryExecutionContext = &awstypes.QueryExecutionContext{
+			Catalog:  config.Catalog.ValueStringPointer(),
+			Database: config.Database.ValueStringPointer(),
+		}
+	}
+	if !config.OutputLocation.IsNull() {
+		input.ResultConfiguration = &awstypes.ResultConfiguration{
+			OutputLocation: config.OutputLocation.ValueStringPointer(),
+		}
+	}
+
+	output, err := conn.StartQueryExecution(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError("starting Athena query execution", err.Error())
+		return
+	}
+
+	queryExecutionID := aws.ToString(output.QueryExecutionId)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Query execution %s started, waiting for completion...", queryExecutionID),
+	})
+
+	// Query state is tracked between polls so that each transition is reported exactly once.
+	var lastState awstypes.QueryExecutionState
+	fr, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.QueryExecution], error) {
+		output, err := findQueryExecutionByID(ctx, conn, queryExecutionID)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.QueryExecution]{}, err
+		}
+
+		if state := output.Status.State; state != lastState {
+			lastState = state
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Query execution %s is %s", queryExecutionID, state),
+			})
+		}
+
+		return actionwait.FetchResult[*awstypes.QueryExecution]{Status: actionwait.Status(output.Status.State), Value: output}, nil
+	}, actionwait.Options[*awstypes.QueryExecution]{
+		Timeout:  timeout,
+		Interval: actionwait.FixedInterval(startQueryExecutionPollInterval),
+		SuccessStates: []actionwait.Status{
+			actionwait.Status(awstypes.QueryExecutionStateSucceeded),
+		},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.QueryExecutionStateQueued),
+			actionwait.Status(awstypes.QueryExecutionStateRunning),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.QueryExecutionStateFailed),
+			actionwait.Status(awstypes.QueryExecutionStateCancelled),
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Query Execution",
+				fmt.Sprintf("Athena query execution %s did not complete within %s (last state: %s)", queryExecutionID, timeout, timeoutErr.LastStatus),
+			)
+		} else if errors.As(err, &failureErr) {
+			resp.Diagnostics.AddError(
+				"Query Execution Failed",
+				fmt.Sprintf("Athena query execution %s %s: %s", queryExecutionID, failureErr.Status, queryExecutionFailureDetail(fr.Value)),
+			)
+		} else {
+			resp.Diagnostics.AddError(fmt.Sprintf("waiting for Athena query execution (%s)", queryExecutionID), err.Error())
+		}
+		return
+	}
+
+	var dataScanned int64
+	if v := fr.Value.Statistics; v != nil {
+		dataScanned = aws.ToInt64(v.DataScannedInBytes)
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Query execution %s succeeded, %d byte(s) scanned", queryExecutionID, dataScanned),
+	})
+
+	tflog.Info(ctx, "Athena query execution succeeded", map[string]any{
+		"query_execution_id": queryExecutionID,
+		"data_scanned_bytes": dataScanned,
+	})
+}
+
+func findQueryExecutionByID(ctx context.Context, conn *athena.Client, id string) (*awstypes.QueryExecution, error) {
+	input := athena.GetQueryExecutionInput{
+		QueryExecutionId: aws.String(id),
+	}
+
+	output, err := conn.GetQueryExecution(ctx, &input)
+	if errs.IsAErrorMessageContains[*awstypes.InvalidRequestException](err, "was not found") {
+		return nil, &sdkretry.NotFoundError{
+			LastError:   err,
+			LastRequest: &input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.QueryExecution == nil || output.QueryExecution.Status == nil {
+		return nil, tfresource.NewEmptyResultError()
+	}
+
+	return output.QueryExecution, nil
+}
+
+func queryExecutionFailureDetail(queryExecution *awstypes.QueryExecution) string {
+	if queryExecution == nil || queryExecution.Status == nil {
+		return "no details available"
+	}
+
+	status := queryExecution.Status
+	if v := status.AthenaError; v != nil && aws.ToString(v.ErrorMessage) != "" {
+		return aws.ToString(v.ErrorMessage)
+	}
+	if v := aws.ToString(status.StateChangeReason); v != "" {
+		return v
+	}
+
+	return "no details available"
+}
@@ -0,0 +1,170 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package athena_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAthenaStartQueryExecutionAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	dataSourceName := "data.aws_athena_query_results.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AthenaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartQueryExecutionActionConfig_basic(rName),
+			},
+			{
+				Config: acctest.ConfigCompose(testAccStartQueryExecutionActionConfig_basic(rName), `
+data "aws_athena_query_results" "test" {
+  workgroup    = aws_athena_workgroup.test.name
+  database     = aws_athena_database.test.name
+  query_string = "SELECT id, name FROM test ORDER BY id"
+
+  depends_on = [terraform_data.trigger]
+}
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "rows.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "rows.0.id", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "rows.0.name", "first"),
+					resource.TestCheckResourceAttr(dataSourceName, "rows.1.id", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "rows.1.name", "second"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAthenaStartQueryExecutionAction_executionParameters(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AthenaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartQueryExecutionActionConfig_executionParameters(rName),
+			},
+		},
+	})
+}
+
+func TestAccAthenaStartQueryExecutionAction_failed(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AthenaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStartQueryExecutionActionConfig_queryString(rName, "SELECT * FROM does_not_exist"),
+				ExpectError: regexache.MustCompile(`(?s)Query Execution Failed.*FAILED`),
+			},
+		},
+	})
+}
+
+func testAccStartQueryExecutionActionConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_athena_workgroup" "test" {
+  name          = %[1]q
+  force_destroy = true
+
+  configuration {
+    result_configuration {
+      output_location = "s3://${aws_s3_bucket.test.bucket}/results/"
+    }
+  }
+}
+
+resource "aws_athena_database" "test" {
+  name          = %[2]q
+  bucket        = aws_s3_bucket.test.bucket
+  force_destroy = true
+}
+`, rName, strings.ReplaceAll(rName, "-", "_"))
+}
+
+func testAccStartQueryExecutionActionConfig_queryString(rName, queryString string) string {
+	return acctest.ConfigCompose(testAccStartQueryExecutionActionConfig_base(rName), fmt.Sprintf(`
+action "aws_athena_start_query_execution" "test" {
+  config {
+    workgroup    = aws_athena_workgroup.test.name
+    database     = aws_athena_database.test.name
+    query_string = %[1]q
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_athena_database.test.name
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_athena_start_query_execution.test]
+    }
+  }
+}
+`, queryString))
+}
+
+func testAccStartQueryExecutionActionConfig_basic(rName string) string {
+	return testAccStartQueryExecutionActionConfig_queryString(rName, "CREATE TABLE test WITH (location = 's3://"+rName+"/test/') AS SELECT * FROM (VALUES (1, 'first'), (2, 'second')) AS t (id, name)")
+}
+
+func testAccStartQueryExecutionActionConfig_executionParameters(rName string) string {
+	return acctest.ConfigCompose(testAccStartQueryExecutionActionConfig_base(rName), `
+action "aws_athena_start_query_execution" "test" {
+  config {
+    workgroup            = aws_athena_workgroup.test.name
+    query_string         = "SELECT ? AS id, ? AS name"
+    execution_parameters = ["1", "'first'"]
+    timeout              = 600
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_athena_workgroup.test.name
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_athena_start_query_execution.test]
+    }
+  }
+}
+`)
+}
@@ -0,0 +1,322 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package autoscaling
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	startInstanceRefreshPollInterval   = 30 * time.Second
+	startInstanceRefreshDefaultTimeout = 60 * time.Minute
+)
+
+// @Action(aws_autoscaling_start_instance_refresh, name="Start Instance Refresh")
+func newStartInstanceRefreshAction(context.Context) (action.ActionWithConfigure, error) {
+	return &startInstanceRefreshAction{}, nil
+}
+
+var (
+	_ action.Action = (*startInstanceRefreshAction)(nil)
+)
+
+type startInstanceRefreshAction struct {
+	framework.ActionWithModel[startInstanceRefreshActionModel]
+}
+
+type startInstanceRefreshActionModel struct {
+	framework.WithRegionModel
+	AutoScalingGroupName types.String                                             `tfsdk:"autoscaling_group_name"`
+	Preferences          fwtypes.ListNestedObjectValueOf[refreshPreferencesModel] `tfsdk:"preferences"`
+	Strategy             fwtypes.StringEnum[awstypes.RefreshStrategy]             `tfsdk:"strategy"`
+	Timeout              types.Int64                                              `tfsdk:"timeout" autoflex:"-"`
+}
+
+type refreshPreferencesModel struct {
+	AlarmSpecification        fwtypes.ListNestedObjectValueOf[alarmSpecificationModel] `tfsdk:"alarm_specification"`
+	AutoRollback              types.Bool                                               `tfsdk:"auto_rollback"`
+	BakeTime                  types.Int64                                              `tfsdk:"bake_time"`
+	CheckpointDelay           types.Int64                                              `tfsdk:"checkpoint_delay"`
+	CheckpointPercentages     fwtypes.ListOfInt64                                      `tfsdk:"checkpoint_percentages"`
+	InstanceWarmup            types.Int64                                              `tfsdk:"instance_warmup"`
+	MaxHealthyPercentage      types.Int64                                              `tfsdk:"max_healthy_percentage"`
+	MinHealthyPercentage      types.Int64                                              `tfsdk:"min_healthy_percentage"`
+	ScaleInProtectedInstances fwtypes.StringEnum[awstypes.ScaleInProtectedInstances]   `tfsdk:"scale_in_protected_instances"`
+	SkipMatching              types.Bool                                               `tfsdk:"skip_matching"`
+	StandbyInstances          fwtypes.StringEnum[awstypes.StandbyInstances]            `tfsdk:"standby_instances"`
+}
+
+type alarmSpecificationModel struct {
+	Alarms fwtypes.ListOfString `tfsdk:"alarms"`
+}
+
+func (a *startInstanceRefreshAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts an instance refresh of an Auto Scaling group and waits for it to complete.",
+		Attributes: map[string]schema.Attribute{
+			"autoscaling_group_name": schema.StringAttribute{
+				Description: "Name of the Auto Scaling group to refresh",
+				Required:    true,
+			},
+			"strategy": schema.StringAttribute{
+				CustomType:  fwtypes.StringEnumType[awstypes.RefreshStrategy](),
+				Description: "Strategy to use for the instance refresh. Defaults to Rolling",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the instance refresh to complete (default: 3600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(86400),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"preferences": schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[refreshPreferencesModel](ctx),
+				Description: "Preferences for the instance refresh",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"auto_rollback": schema.BoolAttribute{
+							Description: "Whether to roll back the Auto Scaling group to its previous configuration if the instance refresh fails or a CloudWatch alarm threshold is met",
+							Optional:    true,
+						},
+						"bake_time": schema.Int64Attribute{
+							Description: "Number of seconds to wait after the instance refresh has replaced all instances before it is marked successful",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 172800),
+							},
+						},
+						"checkpoint_delay": schema.Int64Attribute{
+							Description: "Number of seconds to wait after a checkpoint is reached before continuing",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 172800),
+							},
+						},
+						"checkpoint_percentages": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfInt64Type,
+							Description: "Percentages of instances replaced at which to pause for checkpoint_delay. The last value must be 100",
+							Optional:    true,
+							ElementType: types.Int64Type,
+						},
+						"instance_warmup": schema.Int64Attribute{
+							Description: "Number of seconds until a newly launched instance is configured and ready to use",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+						"max_healthy_percentage": schema.Int64Attribute{
+							Description: "Maximum percentage of the group that can be in service and healthy, or pending, during the instance refresh",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.Between(100, 200),
+							},
+						},
+						"min_healthy_percentage": schema.Int64Attribute{
+							Description: "Minimum percentage of the group that must remain in service and healthy during the instance refresh",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 100),
+							},
+						},
+						"scale_in_protected_instances": schema.StringAttribute{
+							CustomType:  fwtypes.StringEnumType[awstypes.ScaleInProtectedInstances](),
+							Description: "Behavior when instances protected from scale in are found",
+							Optional:    true,
+						},
+						"skip_matching": schema.BoolAttribute{
+							Description: "Whether to skip replacing instances that already match the desired configuration",
+							Optional:    true,
+						},
+						"standby_instances": schema.StringAttribute{
+							CustomType:  fwtypes.StringEnumType[awstypes.StandbyInstances](),
+							Description: "Behavior when instances in Standby state are found",
+							Optional:    true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"alarm_specification": schema.ListNestedBlock{
+							CustomType:  fwtypes.NewListNestedObjectTypeOf[alarmSpecificationModel](ctx),
+							Description: "CloudWatch alarms that, when in ALARM state, fail the instance refresh",
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"alarms": schema.ListAttribute{
+										CustomType:  fwtypes.ListOfStringType,
+										Description: "Names of the CloudWatch alarms",
+										Optional:    true,
+										ElementType: types.StringType,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *startInstanceRefreshAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config startInstanceRefreshActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().AutoScalingClient(ctx)
+
+	name := config.AutoScalingGroupName.ValueString()
+
+	timeout := startInstanceRefreshDefaultTimeout
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting Auto Scaling Group instance refresh", map[string]any{
+		"autoscaling_group_name": name,
+		names.AttrTimeout:        timeout.String(),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Starting instance refresh of Auto Scaling Group %s...", name),
+	})
+
+	var input autoscaling.StartInstanceRefreshInput
+	resp.Diagnostics.Append(fwflex.Expand(ctx, config, &input)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.StartInstanceRefresh(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("starting Auto Scaling Group (%s) instance refresh", name), err.Error())
+		return
+	}
+
+	id := aws.ToString(output.InstanceRefreshId)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Instance refresh %s started, waiting for completion...", id),
+	})
+
+	// Status and percentage complete are tracked between polls so that each change is reported exactly once.
+	var lastStatus awstypes.InstanceRefreshStatus
+	lastPercentage := int32(-1)
+	fr, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.InstanceRefresh], error) {
+		input := autoscaling.DescribeInstanceRefreshesInput{
+			AutoScalingGroupName: aws.String(name),
+			InstanceRefreshIds:   []string{id},
+		}
+		refresh, err := findInstanceRefresh(ctx, conn, &input)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.InstanceRefresh]{}, err
+		}
+
+		if percentage := aws.ToInt32(refresh.PercentageComplete); refresh.Status != lastStatus || percentage != lastPercentage {
+			lastStatus, lastPercentage = refresh.Status, percentage
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Instance refresh %s is %s (%d%% complete, %d instance(s) remaining)", id, refresh.Status, percentage, aws.ToInt32(refresh.InstancesToUpdate)),
+			})
+		}
+
+		return actionwait.FetchResult[*awstypes.InstanceRefresh]{Status: actionwait.Status(refresh.Status), Value: refresh}, nil
+	}, actionwait.Options[*awstypes.InstanceRefresh]{
+		Timeout:  timeout,
+		Interval: actionwait.FixedInterval(startInstanceRefreshPollInterval),
+		SuccessStates: []actionwait.Status{
+			actionwait.Status(awstypes.InstanceRefreshStatusSuccessful),
+		},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.InstanceRefreshStatusPending),
+			actionwait.Status(awstypes.InstanceRefreshStatusInProgress),
+			actionwait.Status(awstypes.InstanceRefreshStatusBaking),
+			actionwait.Status(awstypes.InstanceRefreshStatusCancelling),
+			actionwait.Status(awstypes.InstanceRefreshStatusRollbackInProgress),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.InstanceRefreshStatusFailed),
+			actionwait.Status(awstypes.InstanceRefreshStatusCancelled),
+			actionwait.Status(awstypes.InstanceRefreshStatusRollbackFailed),
+			actionwait.Status(awstypes.InstanceRefreshStatusRollbackSuccessful),
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Instance Refresh",
+				fmt.Sprintf("Auto Scaling Group (%s) instance refresh %s did not complete within %s (last status: %s). The instance refresh has not been cancelled.", name, id, timeout, timeoutErr.LastStatus),
+			)
+		} else if errors.As(err, &failureErr) {
+			resp.Diagnostics.AddError(
+				"Instance Refresh Failed",
+				fmt.Sprintf("Auto Scaling Group (%s) instance refresh %s completed with status %s.%s", name, id, failureErr.Status, instanceRefreshFailureDetail(fr.Value)),
+			)
+		} else {
+			resp.Diagnostics.AddError(fmt.Sprintf("waiting for Auto Scaling Group (%s) instance refresh %s", name, id), err.Error())
+		}
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Instance refresh %s of Auto Scaling Group %s completed successfully", id, name),
+	})
+
+	tflog.Info(ctx, "Auto Scaling Group instance refresh completed", map[string]any{
+		"autoscaling_group_name": name,
+		"instance_refresh_id":    id,
+	})
+}
+
+// instanceRefreshFailureDetail renders the status and rollback reasons of an instance refresh for use in a diagnostic.
+func instanceRefreshFailureDetail(refresh *awstypes.InstanceRefresh) string {
+	if refresh == nil {
+		return ""
+	}
+
+	var sb strings.Builder
+
+	if v := aws.ToString(refresh.StatusReason); v != "" {
+		fmt.Fprintf(&sb, "\n\n%s", v)
+	}
+
+	if v := refresh.RollbackDetails; v != nil {
+		if v := aws.ToString(v.RollbackReason); v != "" {
+			fmt.Fprintf(&sb, "\n\nRollback reason: %s", v)
+		}
+	}
+
+	return sb.String()
+}
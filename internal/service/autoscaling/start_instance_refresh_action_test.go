@@ -0,0 +1,216 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package autoscaling_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAutoScalingStartInstanceRefreshAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AutoScalingServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartInstanceRefreshActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceRefreshStatus(ctx, t, "aws_autoscaling_group.test", awstypes.InstanceRefreshStatusSuccessful),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAutoScalingStartInstanceRefreshAction_preferences(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AutoScalingServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartInstanceRefreshActionConfig_preferences(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceRefreshStatus(ctx, t, "aws_autoscaling_group.test", awstypes.InstanceRefreshStatusSuccessful),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAutoScalingStartInstanceRefreshAction_invalidMinHealthyPercentage(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AutoScalingServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStartInstanceRefreshActionConfig_minHealthyPercentage(rName, 101),
+				ExpectError: regexache.MustCompile(`value must be between 0 and 100`),
+			},
+		},
+	})
+}
+
+// testAccCheckInstanceRefreshStatus verifies that the most recent instance refresh of the Auto Scaling group has the expected status.
+func testAccCheckInstanceRefreshStatus(ctx context.Context, t *testing.T, n string, want awstypes.InstanceRefreshStatus) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).AutoScalingClient(ctx)
+
+		input := autoscaling.DescribeInstanceRefreshesInput{
+			AutoScalingGroupName: aws.String(rs.Primary.ID),
+			MaxRecords:           aws.Int32(1),
+		}
+		output, err := conn.DescribeInstanceRefreshes(ctx, &input)
+
+		if err != nil {
+			return err
+		}
+
+		if len(output.InstanceRefreshes) == 0 {
+			return fmt.Errorf("no instance refreshes found for %s", rs.Primary.ID)
+		}
+
+		if got := output.InstanceRefreshes[0].Status; got != want {
+			return fmt.Errorf("instance refresh status = %s, want %s", got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccStartInstanceRefreshActionConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccGroupConfig_launchTemplateBase(rName, "t3.nano"), fmt.Sprintf(`
+resource "aws_autoscaling_group" "test" {
+  availability_zones = [data.aws_availability_zones.available.names[0]]
+  name               = %[1]q
+  max_size           = 2
+  min_size           = 1
+  desired_capacity   = 1
+
+  launch_template {
+    id      = aws_launch_template.test.id
+    version = aws_launch_template.test.default_version
+  }
+
+  tag {
+    key                 = "Name"
+    value               = %[1]q
+    propagate_at_launch = true
+  }
+}
+`, rName))
+}
+
+func testAccStartInstanceRefreshActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccStartInstanceRefreshActionConfig_base(rName), `
+action "aws_autoscaling_start_instance_refresh" "test" {
+  config {
+    autoscaling_group_name = aws_autoscaling_group.test.name
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_autoscaling_group.test.name
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_autoscaling_start_instance_refresh.test]
+    }
+  }
+}
+`)
+}
+
+func testAccStartInstanceRefreshActionConfig_preferences(rName string) string {
+	return acctest.ConfigCompose(testAccStartInstanceRefreshActionConfig_base(rName), `
+action "aws_autoscaling_start_instance_refresh" "test" {
+  config {
+    autoscaling_group_name = aws_autoscaling_group.test.name
+    strategy               = "Rolling"
+
+    preferences {
+      checkpoint_delay       = 30
+      checkpoint_percentages = [50, 100]
+      instance_warmup        = 0
+      max_healthy_percentage = 200
+      min_healthy_percentage = 100
+      skip_matching          = false
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_autoscaling_group.test.name
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_autoscaling_start_instance_refresh.test]
+    }
+  }
+}
+`)
+}
+
+func testAccStartInstanceRefreshActionConfig_minHealthyPercentage(rName string, minHealthyPercentage int) string {
+	return acctest.ConfigCompose(testAccStartInstanceRefreshActionConfig_base(rName), fmt.Sprintf(`
+action "aws_autoscaling_start_instance_refresh" "test" {
+  config {
+    autoscaling_group_name = aws_autoscaling_group.test.name
+
+    preferences {
+      min_healthy_percentage = %[1]d
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_autoscaling_group.test.name
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_autoscaling_start_instance_refresh.test]
+    }
+  }
+}
+`, minHealthyPercentage))
+}
@@ -0,0 +1,305 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/backup"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/backup/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	startBackupJobPollInterval   = 30 * time.Second
+	startBackupJobDefaultTimeout = 60 * time.Minute
+)
+
+// @Action(aws_backup_start_backup_job, name="Start Backup Job")
+func newStartBackupJobAction(context.Context) (action.ActionWithConfigure, error) {
+	return &startBackupJobAction{}, nil
+}
+
+var (
+	_ action.Action = (*startBackupJobAction)(nil)
+)
+
+type startBackupJobAction struct {
+	framework.ActionWithModel[startBackupJobActionModel]
+}
+
+type startBackupJobActionModel struct {
+	framework.WithRegionModel
+	BackupOptions          fwtypes.MapOfString                                   `tfsdk:"backup_options"`
+	BackupVaultName        types.String                                          `tfsdk:"backup_vault_name"`
+	CompleteWindowMinutes  types.Int64                                           `tfsdk:"complete_window_minutes"`
+	IAMRoleARN             fwtypes.ARN                                           `tfsdk:"iam_role_arn"`
+	RecoveryPointLifecycle fwtypes.ListNestedObjectValueOf[backupLifecycleModel] `tfsdk:"recovery_point_lifecycle"`
+	RecoveryPointTags      fwtypes.MapOfString                                   `tfsdk:"recovery_point_tags"`
+	ResourceARN            fwtypes.ARN                                           `tfsdk:"resource_arn"`
+	StartWindowMinutes     types.Int64                                           `tfsdk:"start_window_minutes"`
+	Timeout                types.Int64                                           `tfsdk:"timeout"`
+}
+
+// backupLifecycleModel uses the same attribute names as the lifecycle block of aws_backup_plan rules.
+type backupLifecycleModel struct {
+	ColdStorageAfter                    types.Int64 `tfsdk:"cold_storage_after"`
+	DeleteAfter                         types.Int64 `tfsdk:"delete_after"`
+	OptInToArchiveForSupportedResources types.Bool  `tfsdk:"opt_in_to_archive_for_supported_resources"`
+}
+
+func (a *startBackupJobAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts an on-demand AWS Backup job and waits for it to complete.",
+		Attributes: map[string]schema.Attribute{
+			"backup_options": schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				Description: "Backup options for the resource type, for example Windows VSS settings for EC2",
+				Optional:    true,
+			},
+			"backup_vault_name": schema.StringAttribute{
+				Description: "Name of the backup vault where the recovery point is stored",
+				Required:    true,
+			},
+			"complete_window_minutes": schema.Int64Attribute{
+				Description: "Number of minutes after the backup job starts within which it must complete or be canceled by AWS Backup",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"iam_role_arn": schema.StringAttribute{
+				CustomType:  fwtypes.ARNType,
+				Description: "ARN of the IAM role that AWS Backup uses to create the recovery point",
+				Required:    true,
+			},
+			"recovery_point_tags": schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				Description: "Tags to assign to the recovery point",
+				Optional:    true,
+			},
+			names.AttrResourceARN: schema.StringAttribute{
+				CustomType:  fwtypes.ARNType,
+				Description: "ARN of the resource to back up",
+				Required:    true,
+			},
+			"start_window_minutes": schema.Int64Attribute{
+				Description: "Number of minutes after the job is created within which it must start or be canceled by AWS Backup",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+				},
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the backup job to complete (default: 3600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(604800),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"recovery_point_lifecycle": schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[backupLifecycleModel](ctx),
+				Description: "Lifecycle of the recovery point",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"cold_storage_after": schema.Int64Attribute{
+							Description: "Number of days after creation that the recovery point is moved to cold storage",
+							Optional:    true,
+						},
+						"delete_after": schema.Int64Attribute{
+							Description: "Number of days after creation that the recovery point is deleted",
+							Optional:    true,
+						},
+						"opt_in_to_archive_for_supported_resources": schema.BoolAttribute{
+							Description: "Whether the recovery point is archived to cold storage for supported resource types",
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *startBackupJobAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config startBackupJobActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().BackupClient(ctx)
+
+	resourceARN := config.ResourceARN.ValueString()
+	vaultName := config.BackupVaultName.ValueString()
+
+	timeout := startBackupJobDefaultTimeout
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting AWS Backup job", map[string]any{
+		names.AttrResourceARN: resourceARN,
+		"backup_vault_name":   vaultName,
+		names.AttrTimeout:     timeout.String(),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Starting backup job for %s...", resourceARN),
+	})
+
+	input := backup.StartBackupJobInput{
+		BackupOptions:         fwflex.ExpandFrameworkStringValueMap(ctx, config.BackupOptions),
+		BackupVaultName:       aws.String(vaultName),
+		CompleteWindowMinutes: config.CompleteWindowMinutes.ValueInt64Pointer(),
+		IamRoleArn:            config.IAMRoleARN.ValueStringPointer(),
+		RecoveryPointTags:     fwflex.ExpandFrameworkStringValueMap(ctx, config.RecoveryPointTags),
+		ResourceArn:           aws.String(resourceARN),
+		StartWindowMinutes:    config.StartWindowMinutes.ValueInt64Pointer(),
+	}
+	lifecycle, diags := config.RecoveryPointLifecycle.ToPtr(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if lifecycle != nil {
+		input.Lifecycle = &awstypes.Lifecycle{
+			DeleteAfterDays:                     lifecycle.DeleteAfter.ValueInt64Pointer(),
+			MoveToColdStorageAfterDays:          lifecycle.ColdStorageAfter.ValueInt64Pointer(),
+			OptInToArchiveForSupportedResources: lifecycle.OptInToArchiveForSupportedResources.ValueBoolPointer(),
+		}
+	}
+
+	output, err := conn.StartBackupJob(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("starting Backup Job for resource (%s)", resourceARN), err.Error())
+		return
+	}
+
+	jobID := aws.ToString(output.BackupJobId)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Backup job %s started, waiting for completion...", jobID),
+	})
+
+	// Job state and progress are tracked between polls so that each change is reported exactly once.
+	var lastState awstypes.BackupJobState
+	var lastPercentDone string
+	fr, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*backup.DescribeBackupJobOutput], error) {
+		output, err := findBackupJobByID(ctx, conn, jobID)
+		if err != nil {
+			return actionwait.FetchResult[*backup.DescribeBackupJobOutput]{}, err
+		}
+
+		if state, percentDone := output.State, strings.TrimSuffix(aws.ToString(output.PercentDone), "%"); state != lastState || percentDone != lastPercentDone {
+			lastState, lastPercentDone = state, percentDone
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Backup job %s is %s (%s%% done)", jobID, state, percentDone),
+			})
+		}
+
+		return actionwait.FetchResult[*backup.DescribeBackupJobOutput]{Status: actionwait.Status(output.State), Value: output}, nil
+	}, actionwait.Options[*backup.DescribeBackupJobOutput]{
+		Timeout:  timeout,
+		Interval: actionwait.FixedInterval(startBackupJobPollInterval),
+		SuccessStates: []actionwait.Status{
+			actionwait.Status(awstypes.BackupJobStateCompleted),
+		},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.BackupJobStateCreated),
+			actionwait.Status(awstypes.BackupJobStatePending),
+			actionwait.Status(awstypes.BackupJobStateRunning),
+			actionwait.Status(awstypes.BackupJobStateAborting),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.BackupJobStateAborted),
+			actionwait.Status(awstypes.BackupJobStateExpired),
+			actionwait.Status(awstypes.BackupJobStateFailed),
+			actionwait.Status(awstypes.BackupJobStatePartial),
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Backup Job",
+				fmt.Sprintf("Backup Job (%s) did not complete within %s (last state: %s)", jobID, timeout, timeoutErr.LastStatus),
+			)
+		} else if errors.As(err, &failureErr) {
+			message := aws.ToString(fr.Value.StatusMessage)
+			if message == "" {
+				message = "no status message available"
+			}
+			resp.Diagnostics.AddError(
+				"Backup Job Failed",
+				fmt.Sprintf("Backup Job (%s) %s: %s", jobID, failureErr.Status, message),
+			)
+		} else {
+			resp.Diagnostics.AddError(fmt.Sprintf("waiting for Backup Job (%s)", jobID), err.Error())
+		}
+		return
+	}
+
+	recoveryPointARN := aws.ToString(fr.Value.RecoveryPointArn)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Backup job %s completed, recovery point: %s", jobID, recoveryPointARN),
+	})
+
+	tflog.Info(ctx, "AWS Backup job completed", map[string]any{
+		"backup_job_id":      jobID,
+		"recovery_point_arn": recoveryPointARN,
+	})
+}
+
+func findBackupJobByID(ctx context.Context, conn *backup.Client, id string) (*backup.DescribeBackupJobOutput, error) { // nosemgrep:ci.backup-in-func-name
+	input := &backup.DescribeBackupJobInput{
+		BackupJobId: aws.String(id),
+	}
+
+	output, err := conn.DescribeBackupJob(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &sdkretry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError()
+	}
+
+	return output, nil
+}
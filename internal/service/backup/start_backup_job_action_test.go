@@ -0,0 +1,164 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package backup_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/backup"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccBackupStartBackupJobAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	var recoveryPointARN string
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BackupServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckVaultDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartBackupJobActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStartBackupJobActionRecoveryPoint(ctx, t, "aws_backup_vault.test", &recoveryPointARN),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckStartBackupJobActionRecoveryPoint verifies that the vault contains a completed recovery point
+// tagged by the action and returns its ARN.
+func testAccCheckStartBackupJobActionRecoveryPoint(ctx context.Context, t *testing.T, n string, v *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).BackupClient(ctx)
+
+		input := backup.ListRecoveryPointsByBackupVaultInput{
+			BackupVaultName: aws.String(rs.Primary.ID),
+		}
+		pages := backup.NewListRecoveryPointsByBackupVaultPaginator(conn, &input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				return err
+			}
+
+			for _, recoveryPoint := range page.RecoveryPoints {
+				recoveryPointARN := aws.ToString(recoveryPoint.RecoveryPointArn)
+
+				tags, err := conn.ListTags(ctx, &backup.ListTagsInput{
+					ResourceArn: aws.String(recoveryPointARN),
+				})
+				if err != nil {
+					return err
+				}
+
+				if tags.Tags["Purpose"] == "pre-migration" {
+					*v = recoveryPointARN
+					return nil
+				}
+			}
+		}
+
+		return fmt.Errorf("Backup Vault (%s) has no recovery point tagged Purpose=pre-migration", rs.Primary.ID)
+	}
+}
+
+func testAccStartBackupJobActionConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "backup.amazonaws.com"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "backup" {
+  role       = aws_iam_role.test.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AWSBackupServiceRolePolicyForBackup"
+}
+
+resource "aws_iam_role_policy_attachment" "restore" {
+  role       = aws_iam_role.test.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AWSBackupServiceRolePolicyForRestores"
+}
+
+resource "aws_backup_vault" "test" {
+  name          = %[1]q
+  force_destroy = true
+}
+
+resource "aws_dynamodb_table" "test" {
+  name         = %[1]q
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "id"
+
+  attribute {
+    name = "id"
+    type = "S"
+  }
+}
+`, rName)
+}
+
+func testAccStartBackupJobActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccStartBackupJobActionConfig_base(rName), `
+action "aws_backup_start_backup_job" "test" {
+  config {
+    backup_vault_name = aws_backup_vault.test.name
+    iam_role_arn      = aws_iam_role.test.arn
+    resource_arn      = aws_dynamodb_table.test.arn
+
+    recovery_point_tags = {
+      Purpose = "pre-migration"
+    }
+
+    recovery_point_lifecycle {
+      delete_after = 7
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_dynamodb_table.test.arn
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_backup_start_backup_job.test]
+    }
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.backup]
+}
+`)
+}
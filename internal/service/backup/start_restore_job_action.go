@@ -0,0 +1,235 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/backup"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/backup/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	startRestoreJobPollInterval   = 30 * time.Second
+	startRestoreJobDefaultTimeout = 60 * time.Minute
+)
+
+// @Action(aws_backup_start_restore_job, name="Start Restore Job")
+func newStartRestoreJobAction(context.Context) (action.ActionWithConfigure, error) {
+	return &startRestoreJobAction{}, nil
+}
+
+var (
+	_ action.Action = (*startRestoreJobAction)(nil)
+)
+
+type startRestoreJobAction struct {
+	framework.ActionWithModel[startRestoreJobActionModel]
+}
+
+type startRestoreJobActionModel struct {
+	framework.WithRegionModel
+	CopySourceTagsToRestoredResource types.Bool          `tfsdk:"copy_source_tags_to_restored_resource"`
+	IAMRoleARN                       fwtypes.ARN         `tfsdk:"iam_role_arn"`
+	Metadata                         fwtypes.MapOfString `tfsdk:"metadata"`
+	RecoveryPointARN                 fwtypes.ARN         `tfsdk:"recovery_point_arn"`
+	ResourceType                     types.String        `tfsdk:"resource_type"`
+	Timeout                          types.Int64         `tfsdk:"timeout"`
+}
+
+func (a *startRestoreJobAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts an AWS Backup restore job and waits for it to complete.",
+		Attributes: map[string]schema.Attribute{
+			"copy_source_tags_to_restored_resource": schema.BoolAttribute{
+				Description: "Whether tags included in the backup are copied to the restored resource",
+				Optional:    true,
+			},
+			"iam_role_arn": schema.StringAttribute{
+				CustomType:  fwtypes.ARNType,
+				Description: "ARN of the IAM role that AWS Backup uses to create the restored resource",
+				Optional:    true,
+			},
+			"metadata": schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				Description: "Resource type specific restore metadata, for example the name of the restored resource",
+				Required:    true,
+			},
+			"recovery_point_arn": schema.StringAttribute{
+				CustomType:  fwtypes.ARNType,
+				Description: "ARN of the recovery point to restore",
+				Required:    true,
+			},
+			names.AttrResourceType: schema.StringAttribute{
+				Description: "Type of resource to restore, for example DynamoDB or EBS",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the restore job to complete (default: 3600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(604800),
+				},
+			},
+		},
+	}
+}
+
+func (a *startRestoreJobAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config startRestoreJobActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().BackupClient(ctx)
+
+	recoveryPointARN := config.RecoveryPointARN.ValueString()
+
+	timeout := startRestoreJobDefaultTimeout
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting AWS Backup restore job", map[string]any{
+		"recovery_point_arn": recoveryPointARN,
+		names.AttrTimeout:    timeout.String(),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Starting restore job for recovery point %s...", recoveryPointARN),
+	})
+
+	input := backup.StartRestoreJobInput{
+		CopySourceTagsToRestoredResource: config.CopySourceTagsToRestoredResource.ValueBool(),
+		IamRoleArn:                       config.IAMRoleARN.ValueStringPointer(),
+		Metadata:                         fwflex.ExpandFrameworkStringValueMap(ctx, config.Metadata),
+		RecoveryPointArn:                 aws.String(recoveryPointARN),
+		ResourceType:                     config.ResourceType.ValueStringPointer(),
+	}
+
+	output, err := conn.StartRestoreJob(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("starting Backup Restore Job for recovery point (%s)", recoveryPointARN), err.Error())
+		return
+	}
+
+	jobID := aws.ToString(output.RestoreJobId)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Restore job %s started, waiting for completion...", jobID),
+	})
+
+	// Job status and progress are tracked between polls so that each change is reported exactly once.
+	var lastStatus awstypes.RestoreJobStatus
+	var lastPercentDone string
+	fr, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*backup.DescribeRestoreJobOutput], error) {
+		output, err := findRestoreJobByID(ctx, conn, jobID)
+		if err != nil {
+			return actionwait.FetchResult[*backup.DescribeRestoreJobOutput]{}, err
+		}
+
+		if status, percentDone := output.Status, strings.TrimSuffix(aws.ToString(output.PercentDone), "%"); status != lastStatus || percentDone != lastPercentDone {
+			lastStatus, lastPercentDone = status, percentDone
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Restore job %s is %s (%s%% done)", jobID, status, percentDone),
+			})
+		}
+
+		return actionwait.FetchResult[*backup.DescribeRestoreJobOutput]{Status: actionwait.Status(output.Status), Value: output}, nil
+	}, actionwait.Options[*backup.DescribeRestoreJobOutput]{
+		Timeout:  timeout,
+		Interval: actionwait.FixedInterval(startRestoreJobPollInterval),
+		SuccessStates: []actionwait.Status{
+			actionwait.Status(awstypes.RestoreJobStatusCompleted),
+		},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.RestoreJobStatusPending),
+			actionwait.Status(awstypes.RestoreJobStatusRunning),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.RestoreJobStatusAborted),
+			actionwait.Status(awstypes.RestoreJobStatusFailed),
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Restore Job",
+				fmt.Sprintf("Backup Restore Job (%s) did not complete within %s (last status: %s)", jobID, timeout, timeoutErr.LastStatus),
+			)
+		} else if errors.As(err, &failureErr) {
+			message := aws.ToString(fr.Value.StatusMessage)
+			if message == "" {
+				message = "no status message available"
+			}
+			resp.Diagnostics.AddError(
+				"Restore Job Failed",
+				fmt.Sprintf("Backup Restore Job (%s) %s: %s", jobID, failureErr.Status, message),
+			)
+		} else {
+			resp.Diagnostics.AddError(fmt.Sprintf("waiting for Backup Restore Job (%s)", jobID), err.Error())
+		}
+		return
+	}
+
+	createdResourceARN := aws.ToString(fr.Value.CreatedResourceArn)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Restore job %s of recovery point %s completed, created resource: %s", jobID, recoveryPointARN, createdResourceARN),
+	})
+
+	tflog.Info(ctx, "AWS Backup restore job completed", map[string]any{
+		"restore_job_id":       jobID,
+		"recovery_point_arn":   recoveryPointARN,
+		"created_resource_arn": createdResourceARN,
+	})
+}
+
+func findRestoreJobByID(ctx context.Context, conn *backup.Client, id string) (*backup.DescribeRestoreJobOutput, error) {
+	input := &backup.DescribeRestoreJobInput{
+		RestoreJobId: aws.String(id),
+	}
+
+	output, err := conn.DescribeRestoreJob(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &sdkretry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError()
+	}
+
+	return output, nil
+}
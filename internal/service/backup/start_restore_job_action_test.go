@@ -0,0 +1,113 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package backup_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccBackupStartRestoreJobAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	restoredTableName := rName + "-restored"
+	var recoveryPointARN string
+	// The recovery point ARN is only known once the first step has run, so the variables are filled in by PreConfig.
+	variables := config.Variables{}
+
+	// The restored table is created outside of Terraform's management.
+	t.Cleanup(func() {
+		conn := acctest.ProviderMeta(ctx, t).DynamoDBClient(ctx)
+		input := dynamodb.DeleteTableInput{
+			TableName: aws.String(restoredTableName),
+		}
+		conn.DeleteTable(ctx, &input) //nolint:errcheck // best-effort cleanup
+	})
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BackupServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckVaultDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartBackupJobActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStartBackupJobActionRecoveryPoint(ctx, t, "aws_backup_vault.test", &recoveryPointARN),
+				),
+			},
+			{
+				PreConfig: func() {
+					variables["recovery_point_arn"] = config.StringVariable(recoveryPointARN)
+				},
+				ConfigVariables: variables,
+				Config:          testAccStartRestoreJobActionConfig_basic(rName, restoredTableName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStartRestoreJobActionTableRestored(ctx, t, restoredTableName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckStartRestoreJobActionTableRestored(ctx context.Context, t *testing.T, tableName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).DynamoDBClient(ctx)
+
+		input := dynamodb.DescribeTableInput{
+			TableName: aws.String(tableName),
+		}
+		if _, err := conn.DescribeTable(ctx, &input); err != nil {
+			return fmt.Errorf("reading restored DynamoDB Table (%s): %w", tableName, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccStartRestoreJobActionConfig_basic(rName, restoredTableName string) string {
+	return acctest.ConfigCompose(testAccStartBackupJobActionConfig_base(rName), fmt.Sprintf(`
+variable "recovery_point_arn" {
+  type = string
+}
+
+action "aws_backup_start_restore_job" "test" {
+  config {
+    iam_role_arn       = aws_iam_role.test.arn
+    recovery_point_arn = var.recovery_point_arn
+    resource_type      = "DynamoDB"
+
+    metadata = {
+      targetTableName = %[1]q
+    }
+  }
+}
+
+resource "terraform_data" "restore" {
+  input = var.recovery_point_arn
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_backup_start_restore_job.test]
+    }
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.restore]
+}
+`, restoredTableName))
+}
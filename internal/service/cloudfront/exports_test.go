@@ -49,5 +49,11 @@ var (
 	FindTrustStoreByID                         = findTrustStoreByID
 	FindVPCOriginByID                          = findVPCOriginByID
 
+	ParseSignedURLPrivateKey = parseSignedURLPrivateKey
+	SignCloudFrontPolicy     = signCloudFrontPolicy
 	WaitDistributionDeployed = waitDistributionDeployed
 )
+
+type (
+	SignedURLPolicy = signedURLPolicy
+)
@@ -32,6 +32,16 @@ func (p *servicePackage) Actions(ctx context.Context) []*inttypes.ServicePackage
 		},
 	}
 }
+func (p *servicePackage) EphemeralResources(ctx context.Context) []*inttypes.ServicePackageEphemeralResource {
+	return []*inttypes.ServicePackageEphemeralResource{
+		{
+			Factory:  newSignedURLEphemeralResource,
+			TypeName: "aws_cloudfront_signed_url",
+			Name:     "Signed URL",
+			Region:   unique.Make(inttypes.ResourceRegionDisabled()),
+		},
+	}
+}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*inttypes.ServicePackageFrameworkDataSource {
 	return []*inttypes.ServicePackageFrameworkDataSource{
@@ -0,0 +1,318 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1" //nolint:gosec // CloudFront signed URLs use SHA-1 with RSA.
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	signedURLDefaultExpiresIn = 3600
+)
+
+// @EphemeralResource(aws_cloudfront_signed_url, name="Signed URL")
+func newSignedURLEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &signedURLEphemeralResource{}, nil
+}
+
+type signedURLEphemeralResource struct {
+	framework.EphemeralResourceWithModel[signedURLEphemeralResourceModel]
+}
+
+func (e *signedURLEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"expiration": schema.StringAttribute{
+				Computed: true,
+			},
+			"expires_in": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"key_pair_id": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrPrivateKey: schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+			},
+			"signed_cookies": schema.MapAttribute{
+				CustomType: fwtypes.MapOfStringType,
+				Computed:   true,
+				Sensitive:  true,
+			},
+			"signed_url": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			names.AttrURL: schema.StringAttribute{
+				Required: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"custom_policy": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[signedURLCustomPolicyModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"date_greater_than": schema.StringAttribute{
+							CustomType: timetypes.RFC3339Type{},
+							Optional:   true,
+						},
+						names.AttrIPAddress: schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								fwvalidators.IPv4CIDRNetworkAddress(),
+							},
+						},
+						"resource": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (e *signedURLEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	data := signedURLEphemeralResourceModel{}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ExpiresIn.IsNull() {
+		data.ExpiresIn = types.Int64Value(signedURLDefaultExpiresIn)
+	}
+
+	rawURL := data.URL.ValueString()
+	privateKey, err := parseSignedURLPrivateKey(data.PrivateKey.ValueString())
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, rawURL)
+		return
+	}
+
+	expiration := time.Now().Add(time.Duration(data.ExpiresIn.ValueInt64()) * time.Second)
+	policy := signedURLPolicy{
+		Resource: rawURL,
+		Expires:  expiration,
+	}
+
+	customPolicy, diags := data.CustomPolicy.ToPtr(ctx)
+	smerr.AddEnrich(ctx, &response.Diagnostics, diags)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if customPolicy != nil {
+		policy.Custom = true
+		policy.IPAddress = customPolicy.IPAddress.ValueString()
+		if v := customPolicy.Resource.ValueString(); v != "" {
+			policy.Resource = v
+		}
+		if !customPolicy.DateGreaterThan.IsNull() {
+			v, diags := customPolicy.DateGreaterThan.ValueRFC3339Time()
+			smerr.AddEnrich(ctx, &response.Diagnostics, diags)
+			if response.Diagnostics.HasError() {
+				return
+			}
+			policy.NotBefore = v
+		}
+	}
+
+	signed, err := signCloudFrontPolicy(privateKey, data.KeyPairID.ValueString(), policy)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, rawURL)
+		return
+	}
+
+	separator := "?"
+	if strings.Contains(rawURL, "?") {
+		separator = "&"
+	}
+
+	cookies := make(map[string]attr.Value, len(signed))
+	queryParams := make([]string, 0, len(signed))
+	for _, v := range signed {
+		cookies["CloudFront-"+v.Name] = types.StringValue(v.Value)
+		queryParams = append(queryParams, v.Name+"="+v.Value)
+	}
+
+	data.Expiration = types.StringValue(expiration.UTC().Format(time.RFC3339))
+	data.SignedCookies = fwtypes.NewMapValueOfMust[types.String](ctx, cookies)
+	data.SignedURL = types.StringValue(rawURL + separator + strings.Join(queryParams, "&"))
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.Result.Set(ctx, &data))
+}
+
+type signedURLEphemeralResourceModel struct {
+	CustomPolicy  fwtypes.ListNestedObjectValueOf[signedURLCustomPolicyModel] `tfsdk:"custom_policy"`
+	Expiration    types.String                                                `tfsdk:"expiration"`
+	ExpiresIn     types.Int64                                                 `tfsdk:"expires_in"`
+	KeyPairID     types.String                                                `tfsdk:"key_pair_id"`
+	PrivateKey    types.String                                                `tfsdk:"private_key"`
+	SignedCookies fwtypes.MapOfString                                         `tfsdk:"signed_cookies"`
+	SignedURL     types.String                                                `tfsdk:"signed_url"`
+	URL           types.String                                                `tfsdk:"url"`
+}
+
+type signedURLCustomPolicyModel struct {
+	DateGreaterThan timetypes.RFC3339 `tfsdk:"date_greater_than"`
+	IPAddress       types.String      `tfsdk:"ip_address"`
+	Resource        types.String      `tfsdk:"resource"`
+}
+
+// signedURLPolicy describes the access granted by a CloudFront signed URL or signed cookies.
+// See https://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/private-content-signed-urls.html.
+type signedURLPolicy struct {
+	Custom    bool
+	Expires   time.Time
+	IPAddress string
+	NotBefore time.Time
+	Resource  string
+}
+
+type signedURLParameter struct {
+	Name  string
+	Value string
+}
+
+// signCloudFrontPolicy signs the policy and returns the parameters, in order, to be appended to the URL
+// as query string parameters or, prefixed with "CloudFront-", set as cookies.
+func signCloudFrontPolicy(privateKey *rsa.PrivateKey, keyPairID string, policy signedURLPolicy) ([]signedURLParameter, error) {
+	document, err := policy.document()
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha1.Sum(document) //nolint:gosec // CloudFront signed URLs use SHA-1 with RSA.
+	signature, err := rsa.SignPKCS1v15(rand.Reader, privateKey, crypto.SHA1, hash[:])
+	if err != nil {
+		return nil, fmt.Errorf("signing CloudFront policy: %w", err)
+	}
+
+	var parameters []signedURLParameter
+	if policy.Custom {
+		parameters = append(parameters, signedURLParameter{Name: "Policy", Value: cloudFrontBase64Encode(document)})
+	} else {
+		parameters = append(parameters, signedURLParameter{Name: "Expires", Value: strconv.FormatInt(policy.Expires.Unix(), 10)})
+	}
+	parameters = append(parameters,
+		signedURLParameter{Name: "Signature", Value: cloudFrontBase64Encode(signature)},
+		signedURLParameter{Name: "Key-Pair-Id", Value: keyPairID},
+	)
+
+	return parameters, nil
+}
+
+// document returns the JSON policy document, with no whitespace and no HTML escaping, as expected by CloudFront.
+func (p signedURLPolicy) document() ([]byte, error) {
+	type epochTime struct {
+		EpochTime int64 `json:"AWS:EpochTime"`
+	}
+	type sourceIP struct {
+		SourceIP string `json:"AWS:SourceIp"`
+	}
+	type condition struct {
+		DateLessThan    epochTime  `json:"DateLessThan"`
+		DateGreaterThan *epochTime `json:"DateGreaterThan,omitempty"`
+		IPAddress       *sourceIP  `json:"IpAddress,omitempty"`
+	}
+	type statement struct {
+		Resource  string    `json:"Resource"`
+		Condition condition `json:"Condition"`
+	}
+	type policy struct {
+		Statement []statement `json:"Statement"`
+	}
+
+	s := statement{
+		Resource: p.Resource,
+		Condition: condition{
+			DateLessThan: epochTime{EpochTime: p.Expires.Unix()},
+		},
+	}
+	if p.Custom {
+		if p.IPAddress != "" {
+			s.Condition.IPAddress = &sourceIP{SourceIP: p.IPAddress}
+		}
+		if !p.NotBefore.IsZero() {
+			s.Condition.DateGreaterThan = &epochTime{EpochTime: p.NotBefore.Unix()}
+		}
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(policy{Statement: []statement{s}}); err != nil {
+		return nil, fmt.Errorf("encoding CloudFront policy: %w", err)
+	}
+
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// cloudFrontBase64Encode returns the base64 encoding of b with the characters that are invalid in a URL
+// query string replaced as CloudFront requires.
+func cloudFrontBase64Encode(b []byte) string {
+	return strings.NewReplacer("+", "-", "=", "_", "/", "~").Replace(base64.StdEncoding.EncodeToString(b))
+}
+
+// parseSignedURLPrivateKey parses a PEM-encoded PKCS #1 or PKCS #8 RSA private key.
+func parseSignedURLPrivateKey(v string) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(v))
+	if block == nil {
+		return nil, errors.New("private key is not PEM-encoded")
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("parsing private key: %w", err)
+	}
+
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("private key is a %T, not an RSA private key", key)
+	}
+
+	return rsaKey, nil
+}
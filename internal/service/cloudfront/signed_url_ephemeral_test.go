@@ -0,0 +1,259 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront_test
+
+import (
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha1" //nolint:gosec // CloudFront signed URLs use SHA-1 with RSA.
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfcloudfront "github.com/hashicorp/terraform-provider-aws/internal/service/cloudfront"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestSignCloudFrontPolicy(t *testing.T) {
+	t.Parallel()
+
+	privateKey, err := tfcloudfront.ParseSignedURLPrivateKey(acctest.TLSRSAPrivateKeyPEM(t, 2048))
+	if err != nil {
+		t.Fatalf("parsing private key: %s", err)
+	}
+
+	expires := time.Unix(1767225600, 0)
+	notBefore := time.Unix(1767222000, 0)
+
+	testCases := map[string]struct {
+		policy       tfcloudfront.SignedURLPolicy
+		wantNames    []string
+		wantDocument string
+	}{
+		"canned": {
+			policy: tfcloudfront.SignedURLPolicy{
+				Expires:  expires,
+				Resource: "https://d111111abcdef8.cloudfront.net/image.jpg?a=1&b=2",
+			},
+			wantNames:    []string{"Expires", "Signature", "Key-Pair-Id"},
+			wantDocument: `{"Statement":[{"Resource":"https://d111111abcdef8.cloudfront.net/image.jpg?a=1&b=2","Condition":{"DateLessThan":{"AWS:EpochTime":1767225600}}}]}`,
+		},
+		"custom": {
+			policy: tfcloudfront.SignedURLPolicy{
+				Custom:    true,
+				Expires:   expires,
+				IPAddress: "192.0.2.0/24",
+				NotBefore: notBefore,
+				Resource:  "https://d111111abcdef8.cloudfront.net/*",
+			},
+			wantNames:    []string{"Policy", "Signature", "Key-Pair-Id"},
+			wantDocument: `{"Statement":[{"Resource":"https://d111111abcdef8.cloudfront.net/*","Condition":{"DateLessThan":{"AWS:EpochTime":1767225600},"DateGreaterThan":{"AWS:EpochTime":1767222000},"IpAddress":{"AWS:SourceIp":"192.0.2.0/24"}}}]}`,
+		},
+	}
+
+	decode := func(t *testing.T, v string) []byte {
+		t.Helper()
+
+		b, err := base64.StdEncoding.DecodeString(strings.NewReplacer("-", "+", "_", "=", "~", "/").Replace(v))
+		if err != nil {
+			t.Fatalf("decoding %q: %s", v, err)
+		}
+
+		return b
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			parameters, err := tfcloudfront.SignCloudFrontPolicy(privateKey, "K2JCJMDEHXQW5F", testCase.policy)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := len(parameters), len(testCase.wantNames); got != want {
+				t.Fatalf("got %d parameters, want %d", got, want)
+			}
+
+			values := make(map[string]string)
+			for i, parameter := range parameters {
+				if got, want := parameter.Name, testCase.wantNames[i]; got != want {
+					t.Errorf("parameter %d name = %q, want %q", i, got, want)
+				}
+				if strings.ContainsAny(parameter.Value, "+=/") {
+					t.Errorf("parameter %q value %q is not URL safe", parameter.Name, parameter.Value)
+				}
+				values[parameter.Name] = parameter.Value
+			}
+
+			if got, want := values["Key-Pair-Id"], "K2JCJMDEHXQW5F"; got != want {
+				t.Errorf("Key-Pair-Id = %q, want %q", got, want)
+			}
+
+			if testCase.policy.Custom {
+				if got, want := string(decode(t, values["Policy"])), testCase.wantDocument; got != want {
+					t.Errorf("Policy = %s, want %s", got, want)
+				}
+			} else if got, want := values["Expires"], "1767225600"; got != want {
+				t.Errorf("Expires = %q, want %q", got, want)
+			}
+
+			hash := sha1.Sum([]byte(testCase.wantDocument)) //nolint:gosec // CloudFront signed URLs use SHA-1 with RSA.
+			if err := rsa.VerifyPKCS1v15(&privateKey.PublicKey, crypto.SHA1, hash[:], decode(t, values["Signature"])); err != nil {
+				t.Errorf("verifying signature: %s", err)
+			}
+		})
+	}
+}
+
+func TestAccCloudFrontSignedURLEphemeral_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	key := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CloudFrontEndpointID) },
+		ErrorCheck: acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSignedURLEphemeralConfig_basic(acctest.TLSPEMEscapeNewlines(key)),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("signed_url"), knownvalue.StringRegexp(regexache.MustCompile(`^https://d111111abcdef8\.cloudfront\.net/image\.jpg\?Expires=\d+&Signature=[\w~-]+&Key-Pair-Id=K2JCJMDEHXQW5F$`))),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("signed_cookies"), knownvalue.MapExact(map[string]knownvalue.Check{
+						"CloudFront-Expires":     knownvalue.NotNull(),
+						"CloudFront-Signature":   knownvalue.NotNull(),
+						"CloudFront-Key-Pair-Id": knownvalue.StringExact("K2JCJMDEHXQW5F"),
+					})),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("expires_in"), knownvalue.Int64Exact(3600)),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("expiration"), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
+
+func TestAccCloudFrontSignedURLEphemeral_customPolicy(t *testing.T) {
+	ctx := acctest.Context(t)
+	key := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CloudFrontEndpointID) },
+		ErrorCheck: acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSignedURLEphemeralConfig_customPolicy(acctest.TLSPEMEscapeNewlines(key)),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("signed_url"), knownvalue.StringRegexp(regexache.MustCompile(`^https://d111111abcdef8\.cloudfront\.net/images/image\.jpg\?size=large&Policy=[\w~-]+&Signature=[\w~-]+&Key-Pair-Id=K2JCJMDEHXQW5F$`))),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("signed_cookies"), knownvalue.MapExact(map[string]knownvalue.Check{
+						"CloudFront-Policy":      knownvalue.NotNull(),
+						"CloudFront-Signature":   knownvalue.NotNull(),
+						"CloudFront-Key-Pair-Id": knownvalue.StringExact("K2JCJMDEHXQW5F"),
+					})),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("expires_in"), knownvalue.Int64Exact(600)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccCloudFrontSignedURLEphemeral_publicKey(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	privateKey := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	publicKey := acctest.TLSRSAPublicKeyPEM(t, privateKey)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CloudFrontEndpointID) },
+		ErrorCheck: acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             testAccCheckPublicKeyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSignedURLEphemeralConfig_publicKey(rName, acctest.TLSPEMEscapeNewlines(privateKey), acctest.TLSPEMEscapeNewlines(publicKey)),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("signed_url"), knownvalue.StringRegexp(regexache.MustCompile(`^https://d111111abcdef8\.cloudfront\.net/image\.jpg\?Expires=\d+&Signature=[\w~-]+&Key-Pair-Id=[0-9A-Z]+$`))),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("expiration"), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
+
+func testAccSignedURLEphemeralConfig_basic(key string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigWithEchoProvider("ephemeral.aws_cloudfront_signed_url.test"),
+		fmt.Sprintf(`
+ephemeral "aws_cloudfront_signed_url" "test" {
+  url         = "https://d111111abcdef8.cloudfront.net/image.jpg"
+  key_pair_id = "K2JCJMDEHXQW5F"
+  private_key = "%[1]s"
+}
+`, key))
+}
+
+func testAccSignedURLEphemeralConfig_customPolicy(key string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigWithEchoProvider("ephemeral.aws_cloudfront_signed_url.test"),
+		fmt.Sprintf(`
+ephemeral "aws_cloudfront_signed_url" "test" {
+  url         = "https://d111111abcdef8.cloudfront.net/images/image.jpg?size=large"
+  key_pair_id = "K2JCJMDEHXQW5F"
+  private_key = "%[1]s"
+  expires_in  = 600
+
+  custom_policy {
+    resource          = "https://d111111abcdef8.cloudfront.net/images/*"
+    ip_address        = "192.0.2.0/24"
+    date_greater_than = "2026-01-01T00:00:00Z"
+  }
+}
+`, key))
+}
+
+func testAccSignedURLEphemeralConfig_publicKey(rName, privateKey, publicKey string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigWithEchoProvider("ephemeral.aws_cloudfront_signed_url.test"),
+		fmt.Sprintf(`
+resource "aws_cloudfront_public_key" "test" {
+  name        = %[1]q
+  encoded_key = "%[3]s"
+}
+
+ephemeral "aws_cloudfront_signed_url" "test" {
+  url         = "https://d111111abcdef8.cloudfront.net/image.jpg"
+  key_pair_id = aws_cloudfront_public_key.test.id
+  private_key = "%[2]s"
+}
+`, rName, privateKey, publicKey))
+}
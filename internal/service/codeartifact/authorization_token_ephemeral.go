@@ -0,0 +1,105 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package codeartifact
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codeartifact"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource(aws_codeartifact_authorization_token, name="Authorization Token")
+func newAuthorizationTokenEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &authorizationTokenEphemeralResource{}, nil
+}
+
+type authorizationTokenEphemeralResource struct {
+	framework.EphemeralResourceWithModel[authorizationTokenEphemeralResourceModel]
+}
+
+func (e *authorizationTokenEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"authorization_token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			names.AttrDomain: schema.StringAttribute{
+				Required: true,
+			},
+			"domain_owner": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					fwvalidators.AWSAccountID(),
+				},
+			},
+			"duration_seconds": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Any(
+						int64validator.Between(900, 43200),
+						int64validator.OneOf(0),
+					),
+				},
+			},
+			"expiration": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (e *authorizationTokenEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	conn := e.Meta().CodeArtifactClient(ctx)
+	data := authorizationTokenEphemeralResourceModel{}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.DomainOwner.IsNull() || data.DomainOwner.IsUnknown() {
+		data.DomainOwner = types.StringValue(e.Meta().AccountID(ctx))
+	}
+
+	input := codeartifact.GetAuthorizationTokenInput{
+		Domain:      data.Domain.ValueStringPointer(),
+		DomainOwner: data.DomainOwner.ValueStringPointer(),
+	}
+	if !data.DurationSeconds.IsNull() {
+		input.DurationSeconds = data.DurationSeconds.ValueInt64Pointer()
+	}
+
+	out, err := conn.GetAuthorizationToken(ctx, &input)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, data.Domain.ValueString())
+		return
+	}
+
+	data.AuthorizationToken = types.StringPointerValue(out.AuthorizationToken)
+	data.Expiration = types.StringValue(aws.ToTime(out.Expiration).Format(time.RFC3339))
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.Result.Set(ctx, &data))
+}
+
+type authorizationTokenEphemeralResourceModel struct {
+	framework.WithRegionModel
+	AuthorizationToken types.String `tfsdk:"authorization_token"`
+	Domain             types.String `tfsdk:"domain"`
+	DomainOwner        types.String `tfsdk:"domain_owner"`
+	DurationSeconds    types.Int64  `tfsdk:"duration_seconds"`
+	Expiration         types.String `tfsdk:"expiration"`
+}
@@ -0,0 +1,615 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package databrew
+
+import (
+	"context"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/databrew"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/databrew/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_databrew_dataset", name="Dataset")
+// @Tags(identifierAttribute="arn")
+// @IdentityAttribute("name")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/databrew;databrew.DescribeDatasetOutput")
+// @Testing(importStateIdAttribute="name")
+// @Testing(hasNoPreExistingResource=true)
+func newDatasetResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &datasetResource{}, nil
+}
+
+type datasetResource struct {
+	framework.ResourceWithModel[datasetResourceModel]
+	framework.WithImportByIdentity
+}
+
+func (r *datasetResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	s3LocationBlock := func() schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[s3LocationModel](ctx),
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: s3LocationAttributes(),
+			},
+		}
+	}
+	filterExpressionBlock := func() schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[filterExpressionModel](ctx),
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					names.AttrExpression: schema.StringAttribute{
+						Required: true,
+						Validators: []validator.String{
+							stringvalidator.LengthBetween(4, 1024),
+						},
+					},
+					"values_map": schema.MapAttribute{
+						CustomType:  fwtypes.MapOfStringType,
+						ElementType: types.StringType,
+						Required:    true,
+					},
+				},
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrFormat: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.InputFormat](),
+				Optional:   true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			names.AttrSource: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.Source](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"format_options": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[formatOptionsModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"csv": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[csvOptionsModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"delimiter": schema.StringAttribute{
+										Optional: true,
+										Validators: []validator.String{
+											stringvalidator.LengthBetween(1, 1),
+										},
+									},
+									"header_row": schema.BoolAttribute{
+										Optional: true,
+									},
+								},
+							},
+						},
+						"excel": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[excelOptionsModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"header_row": schema.BoolAttribute{
+										Optional: true,
+									},
+									"sheet_indexes": schema.ListAttribute{
+										CustomType:  fwtypes.ListOfInt64Type,
+										ElementType: types.Int64Type,
+										Optional:    true,
+										Validators: []validator.List{
+											listvalidator.SizeBetween(1, 1),
+											listvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("sheet_names")),
+										},
+									},
+									"sheet_names": schema.ListAttribute{
+										CustomType:  fwtypes.ListOfStringType,
+										ElementType: types.StringType,
+										Optional:    true,
+										Validators: []validator.List{
+											listvalidator.SizeBetween(1, 1),
+										},
+									},
+								},
+							},
+						},
+						names.AttrJSON: schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[jsonOptionsModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"multi_line": schema.BoolAttribute{
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"input": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[inputModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"data_catalog_input_definition": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[dataCatalogInputDefinitionModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrCatalogID: schema.StringAttribute{
+										Optional: true,
+										Computed: true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.UseStateForUnknown(),
+										},
+									},
+									names.AttrDatabaseName: schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrTableName: schema.StringAttribute{
+										Required: true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"temp_directory": s3LocationBlock(),
+								},
+							},
+						},
+						"database_input_definition": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[databaseInputDefinitionModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"database_table_name": schema.StringAttribute{
+										Optional: true,
+									},
+									"glue_connection_name": schema.StringAttribute{
+										Required: true,
+									},
+									"query_string": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"temp_directory": s3LocationBlock(),
+								},
+							},
+						},
+						"metadata": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[metadataModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"source_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Optional:   true,
+									},
+								},
+							},
+						},
+						"s3_input_definition": s3LocationBlock(),
+					},
+				},
+			},
+			"path_options": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[pathOptionsModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"files_limit": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[filesLimitModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"max_files": schema.Int64Attribute{
+										Required: true,
+										Validators: []validator.Int64{
+											int64validator.AtLeast(1),
+										},
+									},
+									"order": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.Order](),
+										Optional:   true,
+										Computed:   true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.UseStateForUnknown(),
+										},
+									},
+									"ordered_by": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.OrderedBy](),
+										Optional:   true,
+										Computed:   true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.UseStateForUnknown(),
+										},
+									},
+								},
+							},
+						},
+						"last_modified_date_condition": filterExpressionBlock(),
+						"parameter": schema.SetNestedBlock{
+							CustomType: fwtypes.NewSetNestedObjectTypeOf[datasetParameterModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"create_column": schema.BoolAttribute{
+										Optional: true,
+									},
+									names.AttrName: schema.StringAttribute{
+										Required: true,
+									},
+									"path_parameter_name": schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrType: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.ParameterType](),
+										Required:   true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"datetime_options": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[datetimeOptionsModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrFormat: schema.StringAttribute{
+													Required: true,
+												},
+												"locale_code": schema.StringAttribute{
+													Optional: true,
+												},
+												"timezone_offset": schema.StringAttribute{
+													Optional: true,
+												},
+											},
+										},
+									},
+									names.AttrFilter: filterExpressionBlock(),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *datasetResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	conn := r.Meta().DataBrewClient(ctx)
+
+	var plan datasetResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &plan))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	var input databrew.CreateDatasetInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, flex.Expand(ctx, plan, &input))
+	if response.Diagnostics.HasError() {
+		return
+	}
+	input.Tags = getTagsIn(ctx)
+
+	_, err := conn.CreateDataset(ctx, &input)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	output, err := findDatasetByName(ctx, conn, name)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, flex.Flatten(ctx, output, &plan))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, plan))
+}
+
+func (r *datasetResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	conn := r.Meta().DataBrewClient(ctx)
+
+	var state datasetResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &state))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Name.ValueString()
+	output, err := findDatasetByName(ctx, conn, name)
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, flex.Flatten(ctx, output, &state))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &state))
+}
+
+func (r *datasetResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	conn := r.Meta().DataBrewClient(ctx)
+
+	var plan, state datasetResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &plan))
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &state))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	diff, d := flex.Diff(ctx, plan, state)
+	smerr.AddEnrich(ctx, &response.Diagnostics, d)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		name := plan.Name.ValueString()
+		var input databrew.UpdateDatasetInput
+		smerr.AddEnrich(ctx, &response.Diagnostics, flex.Expand(ctx, plan, &input))
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateDataset(ctx, &input)
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+			return
+		}
+
+		output, err := findDatasetByName(ctx, conn, name)
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+			return
+		}
+
+		smerr.AddEnrich(ctx, &response.Diagnostics, flex.Flatten(ctx, output, &plan))
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &plan))
+}
+
+func (r *datasetResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	conn := r.Meta().DataBrewClient(ctx)
+
+	var state datasetResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &state))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Name.ValueString()
+	input := databrew.DeleteDatasetInput{
+		Name: aws.String(name),
+	}
+	_, err := conn.DeleteDataset(ctx, &input)
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+}
+
+func findDatasetByName(ctx context.Context, conn *databrew.Client, name string) (*databrew.DescribeDatasetOutput, error) {
+	input := databrew.DescribeDatasetInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.DescribeDataset(ctx, &input)
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			LastError: err,
+		})
+	}
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if output == nil {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError())
+	}
+
+	return output, nil
+}
+
+type datasetResourceModel struct {
+	framework.WithRegionModel
+	Format        fwtypes.StringEnum[awstypes.InputFormat]            `tfsdk:"format"`
+	FormatOptions fwtypes.ListNestedObjectValueOf[formatOptionsModel] `tfsdk:"format_options"`
+	Input         fwtypes.ListNestedObjectValueOf[inputModel]         `tfsdk:"input"`
+	Name          types.String                                        `tfsdk:"name"`
+	PathOptions   fwtypes.ListNestedObjectValueOf[pathOptionsModel]   `tfsdk:"path_options"`
+	ResourceARN   types.String                                        `tfsdk:"arn"`
+	Source        fwtypes.StringEnum[awstypes.Source]                 `tfsdk:"source"`
+	Tags          tftags.Map                                          `tfsdk:"tags"`
+	TagsAll       tftags.Map                                          `tfsdk:"tags_all"`
+}
+
+type formatOptionsModel struct {
+	CSV   fwtypes.ListNestedObjectValueOf[csvOptionsModel]   `tfsdk:"csv"`
+	Excel fwtypes.ListNestedObjectValueOf[excelOptionsModel] `tfsdk:"excel"`
+	JSON  fwtypes.ListNestedObjectValueOf[jsonOptionsModel]  `tfsdk:"json"`
+}
+
+type csvOptionsModel struct {
+	Delimiter types.String `tfsdk:"delimiter"`
+	HeaderRow types.Bool   `tfsdk:"header_row"`
+}
+
+type excelOptionsModel struct {
+	HeaderRow    types.Bool           `tfsdk:"header_row"`
+	SheetIndexes fwtypes.ListOfInt64  `tfsdk:"sheet_indexes"`
+	SheetNames   fwtypes.ListOfString `tfsdk:"sheet_names"`
+}
+
+type jsonOptionsModel struct {
+	MultiLine types.Bool `tfsdk:"multi_line"`
+}
+
+type inputModel struct {
+	DataCatalogInputDefinition fwtypes.ListNestedObjectValueOf[dataCatalogInputDefinitionModel] `tfsdk:"data_catalog_input_definition"`
+	DatabaseInputDefinition    fwtypes.ListNestedObjectValueOf[databaseInputDefinitionModel]    `tfsdk:"database_input_definition"`
+	Metadata                   fwtypes.ListNestedObjectValueOf[metadataModel]                   `tfsdk:"metadata"`
+	S3InputDefinition          fwtypes.ListNestedObjectValueOf[s3LocationModel]                 `tfsdk:"s3_input_definition"`
+}
+
+type dataCatalogInputDefinitionModel struct {
+	CatalogID     types.String                                     `tfsdk:"catalog_id"`
+	DatabaseName  types.String                                     `tfsdk:"database_name"`
+	TableName     types.String                                     `tfsdk:"table_name"`
+	TempDirectory fwtypes.ListNestedObjectValueOf[s3LocationModel] `tfsdk:"temp_directory"`
+}
+
+type databaseInputDefinitionModel struct {
+	DatabaseTableName  types.String                                     `tfsdk:"database_table_name"`
+	GlueConnectionName types.String                                     `tfsdk:"glue_connection_name"`
+	QueryString        types.String                                     `tfsdk:"query_string"`
+	TempDirectory      fwtypes.ListNestedObjectValueOf[s3LocationModel] `tfsdk:"temp_directory"`
+}
+
+type metadataModel struct {
+	SourceARN fwtypes.ARN `tfsdk:"source_arn"`
+}
+
+type pathOptionsModel struct {
+	FilesLimit                fwtypes.ListNestedObjectValueOf[filesLimitModel]       `tfsdk:"files_limit"`
+	LastModifiedDateCondition fwtypes.ListNestedObjectValueOf[filterExpressionModel] `tfsdk:"last_modified_date_condition"`
+	Parameters                fwtypes.SetNestedObjectValueOf[datasetParameterModel]  `tfsdk:"parameter"`
+}
+
+type filesLimitModel struct {
+	MaxFiles  types.Int64                            `tfsdk:"max_files"`
+	Order     fwtypes.StringEnum[awstypes.Order]     `tfsdk:"order"`
+	OrderedBy fwtypes.StringEnum[awstypes.OrderedBy] `tfsdk:"ordered_by"`
+}
+
+type filterExpressionModel struct {
+	Expression types.String        `tfsdk:"expression"`
+	ValuesMap  fwtypes.MapOfString `tfsdk:"values_map"`
+}
+
+type datasetParameterModel struct {
+	CreateColumn    types.Bool                                             `tfsdk:"create_column"`
+	DatetimeOptions fwtypes.ListNestedObjectValueOf[datetimeOptionsModel]  `tfsdk:"datetime_options"`
+	Filter          fwtypes.ListNestedObjectValueOf[filterExpressionModel] `tfsdk:"filter"`
+	MapBlockKey     types.String                                           `tfsdk:"path_parameter_name"`
+	Name            types.String                                           `tfsdk:"name"`
+	Type            fwtypes.StringEnum[awstypes.ParameterType]             `tfsdk:"type"`
+}
+
+type datetimeOptionsModel struct {
+	Format         types.String `tfsdk:"format"`
+	LocaleCode     types.String `tfsdk:"locale_code"`
+	TimezoneOffset types.String `tfsdk:"timezone_offset"`
+}
+
+type s3LocationModel struct {
+	Bucket      types.String `tfsdk:"bucket"`
+	BucketOwner types.String `tfsdk:"bucket_owner"`
+	Key         types.String `tfsdk:"key"`
+}
+
+func s3LocationAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		names.AttrBucket: schema.StringAttribute{
+			Required: true,
+		},
+		"bucket_owner": schema.StringAttribute{
+			Optional: true,
+		},
+		names.AttrKey: schema.StringAttribute{
+			Optional: true,
+		},
+	}
+}
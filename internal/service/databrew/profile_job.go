@@ -0,0 +1,510 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package databrew
+
+import (
+	"context"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/databrew"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/databrew/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_databrew_profile_job", name="Profile Job")
+// @Tags(identifierAttribute="arn")
+// @IdentityAttribute("name")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/databrew;databrew.DescribeJobOutput")
+// @Testing(importStateIdAttribute="name")
+// @Testing(hasNoPreExistingResource=true)
+func newProfileJobResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &profileJobResource{}, nil
+}
+
+type profileJobResource struct {
+	framework.ResourceWithModel[profileJobResourceModel]
+	framework.WithImportByIdentity
+}
+
+func (r *profileJobResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	statisticsConfigurationBlock := func() schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[statisticsConfigurationModel](ctx),
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"included_statistics": schema.ListAttribute{
+						CustomType:  fwtypes.ListOfStringType,
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+				},
+				Blocks: map[string]schema.Block{
+					"override": schema.ListNestedBlock{
+						CustomType: fwtypes.NewListNestedObjectTypeOf[statisticOverrideModel](ctx),
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								names.AttrParameters: schema.MapAttribute{
+									CustomType:  fwtypes.MapOfStringType,
+									ElementType: types.StringType,
+									Required:    true,
+								},
+								"statistic": schema.StringAttribute{
+									Required: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	columnSelectorBlock := func() schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[columnSelectorModel](ctx),
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					names.AttrName: schema.StringAttribute{
+						Optional: true,
+					},
+					"regex": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"dataset_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"encryption_key_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			"encryption_mode": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.EncryptionMode](),
+				Optional:   true,
+			},
+			"log_subscription": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.LogSubscription](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"max_capacity": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"max_retries": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 240),
+				},
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrTimeout: schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrConfiguration: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[profileConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"column_statistics_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[columnStatisticsConfigurationModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"selector":   columnSelectorBlock(),
+									"statistics": statisticsConfigurationBlock(),
+								},
+							},
+						},
+						"dataset_statistics_configuration": statisticsConfigurationBlock(),
+						"entity_detector_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[entityDetectorConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"entity_types": schema.SetAttribute{
+										CustomType:  fwtypes.SetOfStringType,
+										ElementType: types.StringType,
+										Required:    true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"allowed_statistics": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[allowedStatisticsModel](ctx),
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"statistics": schema.SetAttribute{
+													CustomType:  fwtypes.SetOfStringType,
+													ElementType: types.StringType,
+													Required:    true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"profile_column": columnSelectorBlock(),
+					},
+				},
+			},
+			"job_sample": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[jobSampleModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrMode: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.SampleMode](),
+							Optional:   true,
+						},
+						names.AttrSize: schema.Int64Attribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+			"output_location": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[s3LocationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: s3LocationAttributes(),
+				},
+			},
+			"validation_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[validationConfigurationModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"ruleset_arn": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+						"validation_mode": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.ValidationMode](),
+							Optional:   true,
+							Computed:   true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *profileJobResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	conn := r.Meta().DataBrewClient(ctx)
+
+	var plan profileJobResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &plan))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	var input databrew.CreateProfileJobInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, flex.Expand(ctx, plan, &input))
+	if response.Diagnostics.HasError() {
+		return
+	}
+	input.Tags = getTagsIn(ctx)
+
+	_, err := conn.CreateProfileJob(ctx, &input)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	output, err := findJobByTwoPartKey(ctx, conn, name, awstypes.JobTypeProfile)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, flattenProfileJob(ctx, output, &plan))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, plan))
+}
+
+func (r *profileJobResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	conn := r.Meta().DataBrewClient(ctx)
+
+	var state profileJobResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &state))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Name.ValueString()
+	output, err := findJobByTwoPartKey(ctx, conn, name, awstypes.JobTypeProfile)
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, flattenProfileJob(ctx, output, &state))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &state))
+}
+
+func (r *profileJobResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	conn := r.Meta().DataBrewClient(ctx)
+
+	var plan, state profileJobResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &plan))
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &state))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	diff, d := flex.Diff(ctx, plan, state)
+	smerr.AddEnrich(ctx, &response.Diagnostics, d)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		name := plan.Name.ValueString()
+		var input databrew.UpdateProfileJobInput
+		smerr.AddEnrich(ctx, &response.Diagnostics, flex.Expand(ctx, plan, &input))
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateProfileJob(ctx, &input)
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+			return
+		}
+
+		output, err := findJobByTwoPartKey(ctx, conn, name, awstypes.JobTypeProfile)
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+			return
+		}
+
+		smerr.AddEnrich(ctx, &response.Diagnostics, flattenProfileJob(ctx, output, &plan))
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &plan))
+}
+
+func (r *profileJobResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	conn := r.Meta().DataBrewClient(ctx)
+
+	var state profileJobResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &state))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Name.ValueString()
+	smerr.AddEnrich(ctx, &response.Diagnostics, deleteJob(ctx, conn, name))
+}
+
+func deleteJob(ctx context.Context, conn *databrew.Client, name string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	input := databrew.DeleteJobInput{
+		Name: aws.String(name),
+	}
+	_, err := conn.DeleteJob(ctx, &input)
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &diags, err, smerr.ID, name)
+	}
+
+	return diags
+}
+
+// flattenProfileJob flattens a job description into the profile job model.
+// DescribeJob returns the profile output location as the job's only output.
+func flattenProfileJob(ctx context.Context, output *databrew.DescribeJobOutput, data *profileJobResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(flex.Flatten(ctx, output, data, flex.WithFieldNamePrefix("Profile"))...)
+	if diags.HasError() {
+		return diags
+	}
+
+	var location *awstypes.S3Location
+	if len(output.Outputs) > 0 {
+		location = output.Outputs[0].Location
+	}
+	diags.Append(flex.Flatten(ctx, location, &data.OutputLocation)...)
+
+	return diags
+}
+
+func findJobByTwoPartKey(ctx context.Context, conn *databrew.Client, name string, jobType awstypes.JobType) (*databrew.DescribeJobOutput, error) {
+	input := databrew.DescribeJobInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.DescribeJob(ctx, &input)
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			LastError: err,
+		})
+	}
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if output == nil {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError())
+	}
+
+	if output.Type != jobType {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			Message: "job type " + string(output.Type),
+		})
+	}
+
+	return output, nil
+}
+
+type profileJobResourceModel struct {
+	framework.WithRegionModel
+	Configuration            fwtypes.ListNestedObjectValueOf[profileConfigurationModel]    `tfsdk:"configuration"`
+	DatasetName              types.String                                                  `tfsdk:"dataset_name"`
+	EncryptionKeyARN         fwtypes.ARN                                                   `tfsdk:"encryption_key_arn"`
+	EncryptionMode           fwtypes.StringEnum[awstypes.EncryptionMode]                   `tfsdk:"encryption_mode"`
+	JobSample                fwtypes.ListNestedObjectValueOf[jobSampleModel]               `tfsdk:"job_sample"`
+	LogSubscription          fwtypes.StringEnum[awstypes.LogSubscription]                  `tfsdk:"log_subscription"`
+	MaxCapacity              types.Int64                                                   `tfsdk:"max_capacity"`
+	MaxRetries               types.Int64                                                   `tfsdk:"max_retries"`
+	Name                     types.String                                                  `tfsdk:"name"`
+	OutputLocation           fwtypes.ListNestedObjectValueOf[s3LocationModel]              `tfsdk:"output_location"`
+	ResourceARN              types.String                                                  `tfsdk:"arn"`
+	RoleARN                  fwtypes.ARN                                                   `tfsdk:"role_arn"`
+	Tags                     tftags.Map                                                    `tfsdk:"tags"`
+	TagsAll                  tftags.Map                                                    `tfsdk:"tags_all"`
+	Timeout                  types.Int64                                                   `tfsdk:"timeout"`
+	ValidationConfigurations fwtypes.ListNestedObjectValueOf[validationConfigurationModel] `tfsdk:"validation_configuration"`
+}
+
+type profileConfigurationModel struct {
+	ColumnStatisticsConfigurations fwtypes.ListNestedObjectValueOf[columnStatisticsConfigurationModel] `tfsdk:"column_statistics_configuration"`
+	DatasetStatisticsConfiguration fwtypes.ListNestedObjectValueOf[statisticsConfigurationModel]       `tfsdk:"dataset_statistics_configuration"`
+	EntityDetectorConfiguration    fwtypes.ListNestedObjectValueOf[entityDetectorConfigurationModel]   `tfsdk:"entity_detector_configuration"`
+	ProfileColumns                 fwtypes.ListNestedObjectValueOf[columnSelectorModel]                `tfsdk:"profile_column"`
+}
+
+type columnStatisticsConfigurationModel struct {
+	Selectors  fwtypes.ListNestedObjectValueOf[columnSelectorModel]          `tfsdk:"selector"`
+	Statistics fwtypes.ListNestedObjectValueOf[statisticsConfigurationModel] `tfsdk:"statistics"`
+}
+
+type statisticsConfigurationModel struct {
+	IncludedStatistics fwtypes.ListOfString                                    `tfsdk:"included_statistics"`
+	Overrides          fwtypes.ListNestedObjectValueOf[statisticOverrideModel] `tfsdk:"override"`
+}
+
+type statisticOverrideModel struct {
+	Parameters fwtypes.MapOfString `tfsdk:"parameters"`
+	Statistic  types.String        `tfsdk:"statistic"`
+}
+
+type entityDetectorConfigurationModel struct {
+	AllowedStatistics fwtypes.ListNestedObjectValueOf[allowedStatisticsModel] `tfsdk:"allowed_statistics"`
+	EntityTypes       fwtypes.SetOfString                                     `tfsdk:"entity_types"`
+}
+
+type allowedStatisticsModel struct {
+	Statistics fwtypes.SetOfString `tfsdk:"statistics"`
+}
+
+type jobSampleModel struct {
+	Mode fwtypes.StringEnum[awstypes.SampleMode] `tfsdk:"mode"`
+	Size types.Int64                             `tfsdk:"size"`
+}
+
+type validationConfigurationModel struct {
+	RulesetARN     fwtypes.ARN                                 `tfsdk:"ruleset_arn"`
+	ValidationMode fwtypes.StringEnum[awstypes.ValidationMode] `tfsdk:"validation_mode"`
+}
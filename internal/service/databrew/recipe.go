@@ -0,0 +1,452 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package databrew
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/databrew"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/databrew/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	recipeVersionLatestWorking = "LATEST_WORKING"
+
+	// See https://docs.aws.amazon.com/databrew/latest/dg/API_BatchDeleteRecipeVersion.html.
+	batchDeleteRecipeVersionMaxItems = 50
+)
+
+// @FrameworkResource("aws_databrew_recipe", name="Recipe")
+// @Tags(identifierAttribute="arn")
+// @IdentityAttribute("name")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/databrew;databrew.DescribeRecipeOutput")
+// @Testing(importStateIdAttribute="name")
+// @Testing(importIgnore="publish")
+// @Testing(hasNoPreExistingResource=true)
+func newRecipeResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &recipeResource{}, nil
+}
+
+type recipeResource struct {
+	framework.ResourceWithModel[recipeResourceModel]
+	framework.WithImportByIdentity
+}
+
+func (r *recipeResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(1024),
+				},
+			},
+			"latest_published_version": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			"publish": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"step": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[recipeStepModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						names.AttrAction: schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[recipeActionModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"operation": schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrParameters: schema.MapAttribute{
+										CustomType:  fwtypes.MapOfStringType,
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+								},
+							},
+						},
+						"condition_expression": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[conditionExpressionModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"condition": schema.StringAttribute{
+										Required: true,
+									},
+									"target_column": schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrValue: schema.StringAttribute{
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *recipeResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	conn := r.Meta().DataBrewClient(ctx)
+
+	var plan recipeResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &plan))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	var input databrew.CreateRecipeInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, flex.Expand(ctx, plan, &input))
+	if response.Diagnostics.HasError() {
+		return
+	}
+	input.Tags = getTagsIn(ctx)
+
+	_, err := conn.CreateRecipe(ctx, &input)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	if plan.Publish.ValueBool() {
+		if err := publishRecipe(ctx, conn, name, plan.Description.ValueStringPointer()); err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+			return
+		}
+	}
+
+	output, err := findRecipeByTwoPartKey(ctx, conn, name, recipeVersionLatestWorking)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, flattenRecipe(ctx, conn, output, &plan))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, plan))
+}
+
+func (r *recipeResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	conn := r.Meta().DataBrewClient(ctx)
+
+	var state recipeResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &state))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Name.ValueString()
+	output, err := findRecipeByTwoPartKey(ctx, conn, name, recipeVersionLatestWorking)
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, flattenRecipe(ctx, conn, output, &state))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &state))
+}
+
+func (r *recipeResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	conn := r.Meta().DataBrewClient(ctx)
+
+	var plan, state recipeResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &plan))
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &state))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+
+	if !plan.Description.Equal(state.Description) || !plan.Steps.Equal(state.Steps) {
+		var input databrew.UpdateRecipeInput
+		smerr.AddEnrich(ctx, &response.Diagnostics, flex.Expand(ctx, plan, &input))
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateRecipe(ctx, &input)
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+			return
+		}
+
+		// Publish the new working version.
+		if plan.Publish.ValueBool() {
+			if err := publishRecipe(ctx, conn, name, plan.Description.ValueStringPointer()); err != nil {
+				smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+				return
+			}
+		}
+	} else if plan.Publish.ValueBool() && !state.Publish.ValueBool() {
+		if err := publishRecipe(ctx, conn, name, plan.Description.ValueStringPointer()); err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+			return
+		}
+	}
+
+	output, err := findRecipeByTwoPartKey(ctx, conn, name, recipeVersionLatestWorking)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, flattenRecipe(ctx, conn, output, &plan))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &plan))
+}
+
+func (r *recipeResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	conn := r.Meta().DataBrewClient(ctx)
+
+	var state recipeResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &state))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Name.ValueString()
+
+	// The working version can only be deleted once all published versions are gone.
+	versions, err := findRecipeVersionsByName(ctx, conn, name)
+	if retry.NotFound(err) {
+		return
+	}
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	recipeVersions := tfslices.ApplyToAll(versions, func(v awstypes.Recipe) string {
+		return aws.ToString(v.RecipeVersion)
+	})
+	for chunk := range slices.Chunk(recipeVersions, batchDeleteRecipeVersionMaxItems) {
+		input := databrew.BatchDeleteRecipeVersionInput{
+			Name:           aws.String(name),
+			RecipeVersions: chunk,
+		}
+		output, err := conn.BatchDeleteRecipeVersion(ctx, &input)
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return
+		}
+		if err == nil && output != nil {
+			err = recipeVersionErrors(output.Errors)
+		}
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+			return
+		}
+	}
+
+	input := databrew.DeleteRecipeVersionInput{
+		Name:          aws.String(name),
+		RecipeVersion: aws.String(recipeVersionLatestWorking),
+	}
+	_, err = conn.DeleteRecipeVersion(ctx, &input)
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+}
+
+// flattenRecipe flattens the recipe's working version and adds its latest published version.
+func flattenRecipe(ctx context.Context, conn *databrew.Client, output *databrew.DescribeRecipeOutput, data *recipeResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(flex.Flatten(ctx, output, data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	name := aws.ToString(output.Name)
+	versions, err := findRecipeVersionsByName(ctx, conn, name)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("reading DataBrew Recipe (%s) versions", name), err.Error())
+		return diags
+	}
+
+	data.LatestPublishedVersion = types.StringNull()
+	if len(versions) > 0 {
+		latest := slices.MaxFunc(versions, func(a, b awstypes.Recipe) int {
+			return aws.ToTime(a.PublishedDate).Compare(aws.ToTime(b.PublishedDate))
+		})
+		data.LatestPublishedVersion = flex.StringToFramework(ctx, latest.RecipeVersion)
+	}
+
+	return diags
+}
+
+func publishRecipe(ctx context.Context, conn *databrew.Client, name string, description *string) error {
+	input := databrew.PublishRecipeInput{
+		Description: description,
+		Name:        aws.String(name),
+	}
+
+	_, err := conn.PublishRecipe(ctx, &input)
+	if err != nil {
+		return fmt.Errorf("publishing DataBrew Recipe (%s): %w", name, err)
+	}
+
+	return nil
+}
+
+func recipeVersionErrors(apiObjects []awstypes.RecipeVersionErrorDetail) error {
+	var err error
+
+	for _, apiObject := range apiObjects {
+		err = errors.Join(err, fmt.Errorf("%s: %s: %s", aws.ToString(apiObject.RecipeVersion), aws.ToString(apiObject.ErrorCode), aws.ToString(apiObject.ErrorMessage)))
+	}
+
+	return err
+}
+
+func findRecipeByTwoPartKey(ctx context.Context, conn *databrew.Client, name, recipeVersion string) (*databrew.DescribeRecipeOutput, error) {
+	input := databrew.DescribeRecipeInput{
+		Name:          aws.String(name),
+		RecipeVersion: aws.String(recipeVersion),
+	}
+
+	output, err := conn.DescribeRecipe(ctx, &input)
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			LastError: err,
+		})
+	}
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if output == nil {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError())
+	}
+
+	return output, nil
+}
+
+func findRecipeVersionsByName(ctx context.Context, conn *databrew.Client, name string) ([]awstypes.Recipe, error) {
+	input := databrew.ListRecipeVersionsInput{
+		Name: aws.String(name),
+	}
+	var output []awstypes.Recipe
+
+	pages := databrew.NewListRecipeVersionsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, smarterr.NewError(&retry.NotFoundError{
+				LastError: err,
+			})
+		}
+
+		if err != nil {
+			return nil, smarterr.NewError(err)
+		}
+
+		output = append(output, page.Recipes...)
+	}
+
+	return output, nil
+}
+
+type recipeResourceModel struct {
+	framework.WithRegionModel
+	Description            types.String                                     `tfsdk:"description"`
+	LatestPublishedVersion types.String                                     `tfsdk:"latest_published_version"`
+	Name                   types.String                                     `tfsdk:"name"`
+	Publish                types.Bool                                       `tfsdk:"publish"`
+	ResourceARN            types.String                                     `tfsdk:"arn"`
+	Steps                  fwtypes.ListNestedObjectValueOf[recipeStepModel] `tfsdk:"step"`
+	Tags                   tftags.Map                                       `tfsdk:"tags"`
+	TagsAll                tftags.Map                                       `tfsdk:"tags_all"`
+}
+
+type recipeStepModel struct {
+	Action               fwtypes.ListNestedObjectValueOf[recipeActionModel]        `tfsdk:"action"`
+	ConditionExpressions fwtypes.ListNestedObjectValueOf[conditionExpressionModel] `tfsdk:"condition_expression"`
+}
+
+type recipeActionModel struct {
+	Operation  types.String        `tfsdk:"operation"`
+	Parameters fwtypes.MapOfString `tfsdk:"parameters"`
+}
+
+type conditionExpressionModel struct {
+	Condition    types.String `tfsdk:"condition"`
+	TargetColumn types.String `tfsdk:"target_column"`
+	Value        types.String `tfsdk:"value"`
+}
@@ -0,0 +1,456 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package databrew
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/databrew"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/databrew/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_databrew_recipe_job", name="Recipe Job")
+// @Tags(identifierAttribute="arn")
+// @IdentityAttribute("name")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/databrew;databrew.DescribeJobOutput")
+// @Testing(importStateIdAttribute="name")
+// @Testing(hasNoPreExistingResource=true)
+func newRecipeJobResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &recipeJobResource{}, nil
+}
+
+type recipeJobResource struct {
+	framework.ResourceWithModel[recipeJobResourceModel]
+	framework.WithImportByIdentity
+}
+
+func (r *recipeJobResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	s3LocationBlock := func(validators ...validator.List) schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[s3LocationModel](ctx),
+			Validators: append([]validator.List{
+				listvalidator.SizeAtMost(1),
+			}, validators...),
+			NestedObject: schema.NestedBlockObject{
+				Attributes: s3LocationAttributes(),
+			},
+		}
+	}
+	databaseTableOutputOptionsBlock := func(validators ...validator.List) schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[databaseTableOutputOptionsModel](ctx),
+			Validators: append([]validator.List{
+				listvalidator.SizeAtMost(1),
+			}, validators...),
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					names.AttrTableName: schema.StringAttribute{
+						Required: true,
+					},
+				},
+				Blocks: map[string]schema.Block{
+					"temp_directory": s3LocationBlock(),
+				},
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"dataset_name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"encryption_key_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			"encryption_mode": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.EncryptionMode](),
+				Optional:   true,
+			},
+			"log_subscription": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.LogSubscription](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"max_capacity": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"max_retries": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 240),
+				},
+			},
+			"project_name": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("dataset_name")),
+				},
+			},
+			"recipe_reference": framework.ResourceOptionalComputedListOfObjectsAttribute[recipeReferenceModel](ctx, 1, nil, listplanmodifier.RequiresReplaceIfConfigured(), listplanmodifier.UseStateForUnknown()),
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrTimeout: schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"data_catalog_output": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[dataCatalogOutputModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrCatalogID: schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+						},
+						names.AttrDatabaseName: schema.StringAttribute{
+							Required: true,
+						},
+						"overwrite": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  booldefault.StaticBool(false),
+						},
+						names.AttrTableName: schema.StringAttribute{
+							Required: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"database_options": databaseTableOutputOptionsBlock(),
+						"s3_options": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[s3TableOutputOptionsModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									names.AttrLocation: s3LocationBlock(listvalidator.IsRequired()),
+								},
+							},
+						},
+					},
+				},
+			},
+			"database_output": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[databaseOutputModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"database_output_mode": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.DatabaseOutputMode](),
+							Optional:   true,
+							Computed:   true,
+						},
+						"glue_connection_name": schema.StringAttribute{
+							Required: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"database_options": databaseTableOutputOptionsBlock(listvalidator.IsRequired()),
+					},
+				},
+			},
+			"output": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[outputModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"compression_format": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.CompressionFormat](),
+							Optional:   true,
+						},
+						names.AttrFormat: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.OutputFormat](),
+							Optional:   true,
+							Computed:   true,
+						},
+						"max_output_files": schema.Int64Attribute{
+							Optional: true,
+						},
+						"overwrite": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  booldefault.StaticBool(false),
+						},
+						"partition_columns": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"format_options": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[outputFormatOptionsModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"csv": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[csvOutputOptionsModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"delimiter": schema.StringAttribute{
+													Optional: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						names.AttrLocation: s3LocationBlock(listvalidator.IsRequired()),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *recipeJobResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	conn := r.Meta().DataBrewClient(ctx)
+
+	var plan recipeJobResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &plan))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	var input databrew.CreateRecipeJobInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, flex.Expand(ctx, plan, &input))
+	if response.Diagnostics.HasError() {
+		return
+	}
+	input.Tags = getTagsIn(ctx)
+
+	_, err := conn.CreateRecipeJob(ctx, &input)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	output, err := findJobByTwoPartKey(ctx, conn, name, awstypes.JobTypeRecipe)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, flex.Flatten(ctx, output, &plan))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, plan))
+}
+
+func (r *recipeJobResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	conn := r.Meta().DataBrewClient(ctx)
+
+	var state recipeJobResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &state))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Name.ValueString()
+	output, err := findJobByTwoPartKey(ctx, conn, name, awstypes.JobTypeRecipe)
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, flex.Flatten(ctx, output, &state))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &state))
+}
+
+func (r *recipeJobResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	conn := r.Meta().DataBrewClient(ctx)
+
+	var plan, state recipeJobResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &plan))
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &state))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	diff, d := flex.Diff(ctx, plan, state)
+	smerr.AddEnrich(ctx, &response.Diagnostics, d)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		name := plan.Name.ValueString()
+		var input databrew.UpdateRecipeJobInput
+		smerr.AddEnrich(ctx, &response.Diagnostics, flex.Expand(ctx, plan, &input))
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateRecipeJob(ctx, &input)
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+			return
+		}
+
+		output, err := findJobByTwoPartKey(ctx, conn, name, awstypes.JobTypeRecipe)
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+			return
+		}
+
+		smerr.AddEnrich(ctx, &response.Diagnostics, flex.Flatten(ctx, output, &plan))
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &plan))
+}
+
+func (r *recipeJobResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	conn := r.Meta().DataBrewClient(ctx)
+
+	var state recipeJobResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &state))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, deleteJob(ctx, conn, state.Name.ValueString()))
+}
+
+type recipeJobResourceModel struct {
+	framework.WithRegionModel
+	DataCatalogOutputs fwtypes.ListNestedObjectValueOf[dataCatalogOutputModel] `tfsdk:"data_catalog_output"`
+	DatabaseOutputs    fwtypes.ListNestedObjectValueOf[databaseOutputModel]    `tfsdk:"database_output"`
+	DatasetName        types.String                                            `tfsdk:"dataset_name"`
+	EncryptionKeyARN   fwtypes.ARN                                             `tfsdk:"encryption_key_arn"`
+	EncryptionMode     fwtypes.StringEnum[awstypes.EncryptionMode]             `tfsdk:"encryption_mode"`
+	LogSubscription    fwtypes.StringEnum[awstypes.LogSubscription]            `tfsdk:"log_subscription"`
+	MaxCapacity        types.Int64                                             `tfsdk:"max_capacity"`
+	MaxRetries         types.Int64                                             `tfsdk:"max_retries"`
+	Name               types.String                                            `tfsdk:"name"`
+	Outputs            fwtypes.ListNestedObjectValueOf[outputModel]            `tfsdk:"output"`
+	ProjectName        types.String                                            `tfsdk:"project_name"`
+	RecipeReference    fwtypes.ListNestedObjectValueOf[recipeReferenceModel]   `tfsdk:"recipe_reference"`
+	ResourceARN        types.String                                            `tfsdk:"arn"`
+	RoleARN            fwtypes.ARN                                             `tfsdk:"role_arn"`
+	Tags               tftags.Map                                              `tfsdk:"tags"`
+	TagsAll            tftags.Map                                              `tfsdk:"tags_all"`
+	Timeout            types.Int64                                             `tfsdk:"timeout"`
+}
+
+type recipeReferenceModel struct {
+	Name          types.String `tfsdk:"name"`
+	RecipeVersion types.String `tfsdk:"recipe_version"`
+}
+
+type outputModel struct {
+	CompressionFormat fwtypes.StringEnum[awstypes.CompressionFormat]            `tfsdk:"compression_format"`
+	Format            fwtypes.StringEnum[awstypes.OutputFormat]                 `tfsdk:"format"`
+	FormatOptions     fwtypes.ListNestedObjectValueOf[outputFormatOptionsModel] `tfsdk:"format_options"`
+	Location          fwtypes.ListNestedObjectValueOf[s3LocationModel]          `tfsdk:"location"`
+	MaxOutputFiles    types.Int64                                               `tfsdk:"max_output_files"`
+	Overwrite         types.Bool                                                `tfsdk:"overwrite"`
+	PartitionColumns  fwtypes.ListOfString                                      `tfsdk:"partition_columns"`
+}
+
+type outputFormatOptionsModel struct {
+	CSV fwtypes.ListNestedObjectValueOf[csvOutputOptionsModel] `tfsdk:"csv"`
+}
+
+type csvOutputOptionsModel struct {
+	Delimiter types.String `tfsdk:"delimiter"`
+}
+
+type dataCatalogOutputModel struct {
+	CatalogID       types.String                                                     `tfsdk:"catalog_id"`
+	DatabaseName    types.String                                                     `tfsdk:"database_name"`
+	DatabaseOptions fwtypes.ListNestedObjectValueOf[databaseTableOutputOptionsModel] `tfsdk:"database_options"`
+	Overwrite       types.Bool                                                       `tfsdk:"overwrite"`
+	S3Options       fwtypes.ListNestedObjectValueOf[s3TableOutputOptionsModel]       `tfsdk:"s3_options"`
+	TableName       types.String                                                     `tfsdk:"table_name"`
+}
+
+type databaseTableOutputOptionsModel struct {
+	TableName     types.String                                     `tfsdk:"table_name"`
+	TempDirectory fwtypes.ListNestedObjectValueOf[s3LocationModel] `tfsdk:"temp_directory"`
+}
+
+type s3TableOutputOptionsModel struct {
+	Location fwtypes.ListNestedObjectValueOf[s3LocationModel] `tfsdk:"location"`
+}
+
+type databaseOutputModel struct {
+	DatabaseOptions    fwtypes.ListNestedObjectValueOf[databaseTableOutputOptionsModel] `tfsdk:"database_options"`
+	DatabaseOutputMode fwtypes.StringEnum[awstypes.DatabaseOutputMode]                  `tfsdk:"database_output_mode"`
+	GlueConnectionName types.String                                                     `tfsdk:"glue_connection_name"`
+}
@@ -0,0 +1,10 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+list "aws_vpc_security_group_ingress_rule" "test" {
+  provider = aws
+
+  config {
+    security_group_id = aws_security_group.test.id
+  }
+}
@@ -0,0 +1,35 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+)
+
+// Function annotations are used for list resource registration to the Provider. DO NOT EDIT.
+// @FrameworkListResource("aws_vpc_security_group_egress_rule")
+func newSecurityGroupEgressRuleResourceAsListResource() list.ListResourceWithConfigure {
+	l := &securityGroupEgressRuleListResource{}
+	l.securityGroupRule = l
+
+	return l
+}
+
+var _ list.ListResource = &securityGroupEgressRuleListResource{}
+
+type securityGroupEgressRuleListResource struct {
+	securityGroupEgressRuleResource
+	framework.WithList
+}
+
+func (l *securityGroupEgressRuleListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = securityGroupRuleListResourceConfigSchema()
+}
+
+func (l *securityGroupEgressRuleListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	listSecurityGroupRuleResources(ctx, &l.securityGroupRuleResource, &l.WithList, true, request, stream)
+}
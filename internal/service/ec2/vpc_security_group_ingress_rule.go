@@ -293,27 +293,7 @@ func (r *securityGroupRuleResource) Read(ctx context.Context, request resource.R
 		return
 	}
 
-	data.ARN = r.securityGroupRuleARN(ctx, data.ID.ValueString())
-	data.CIDRIPv4 = fwflex.StringToFramework(ctx, output.CidrIpv4)
-	data.CIDRIPv6 = fwflex.StringToFramework(ctx, output.CidrIpv6)
-	data.Description = fwflex.StringToFramework(ctx, output.Description)
-	data.IPProtocol = fwflex.StringToFrameworkValuable[ipProtocol](ctx, output.IpProtocol)
-	data.PrefixListID = fwflex.StringToFramework(ctx, output.PrefixListId)
-	data.ReferencedSecurityGroupID = flattenReferencedSecurityGroup(ctx, output.ReferencedGroupInfo, r.Meta().AccountID(ctx))
-	data.SecurityGroupID = fwflex.StringToFramework(ctx, output.GroupId)
-	data.SecurityGroupRuleID = fwflex.StringToFramework(ctx, output.SecurityGroupRuleId)
-
-	// If planned from_port or to_port are null and values of -1 are returned, propagate null.
-	if v := aws.ToInt32(output.FromPort); v == -1 && data.FromPort.IsNull() {
-		data.FromPort = types.Int64Null()
-	} else {
-		data.FromPort = fwflex.Int32ToFrameworkInt64(ctx, output.FromPort)
-	}
-	if v := aws.ToInt32(output.ToPort); v == -1 && data.ToPort.IsNull() {
-		data.ToPort = types.Int64Null()
-	} else {
-		data.ToPort = fwflex.Int32ToFrameworkInt64(ctx, output.ToPort)
-	}
+	r.flatten(ctx, output, &data)
 
 	setTagsOut(ctx, output.Tags)
 
@@ -414,6 +394,30 @@ func (r *securityGroupRuleResource) ConfigValidators(context.Context) []resource
 	}
 }
 
+func (r *securityGroupRuleResource) flatten(ctx context.Context, output *awstypes.SecurityGroupRule, data *securityGroupRuleResourceModel) {
+	data.ARN = r.securityGroupRuleARN(ctx, data.ID.ValueString())
+	data.CIDRIPv4 = fwflex.StringToFramework(ctx, output.CidrIpv4)
+	data.CIDRIPv6 = fwflex.StringToFramework(ctx, output.CidrIpv6)
+	data.Description = fwflex.StringToFramework(ctx, output.Description)
+	data.IPProtocol = fwflex.StringToFrameworkValuable[ipProtocol](ctx, output.IpProtocol)
+	data.PrefixListID = fwflex.StringToFramework(ctx, output.PrefixListId)
+	data.ReferencedSecurityGroupID = flattenReferencedSecurityGroup(ctx, output.ReferencedGroupInfo, r.Meta().AccountID(ctx))
+	data.SecurityGroupID = fwflex.StringToFramework(ctx, output.GroupId)
+	data.SecurityGroupRuleID = fwflex.StringToFramework(ctx, output.SecurityGroupRuleId)
+
+	// If planned from_port or to_port are null and values of -1 are returned, propagate null.
+	if v := aws.ToInt32(output.FromPort); v == -1 && data.FromPort.IsNull() {
+		data.FromPort = types.Int64Null()
+	} else {
+		data.FromPort = fwflex.Int32ToFrameworkInt64(ctx, output.FromPort)
+	}
+	if v := aws.ToInt32(output.ToPort); v == -1 && data.ToPort.IsNull() {
+		data.ToPort = types.Int64Null()
+	} else {
+		data.ToPort = fwflex.Int32ToFrameworkInt64(ctx, output.ToPort)
+	}
+}
+
 func (r *securityGroupRuleResource) securityGroupRuleARN(ctx context.Context, id string) types.String {
 	return types.StringValue(r.Meta().RegionalARN(ctx, names.EC2, fmt.Sprintf("security-group-rule/%s", id)))
 }
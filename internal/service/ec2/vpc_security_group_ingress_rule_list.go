@@ -0,0 +1,138 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+)
+
+// Function annotations are used for list resource registration to the Provider. DO NOT EDIT.
+// @FrameworkListResource("aws_vpc_security_group_ingress_rule")
+func newSecurityGroupIngressRuleResourceAsListResource() list.ListResourceWithConfigure {
+	l := &securityGroupIngressRuleListResource{}
+	l.securityGroupRule = l
+
+	return l
+}
+
+var _ list.ListResource = &securityGroupIngressRuleListResource{}
+
+type securityGroupIngressRuleListResource struct {
+	securityGroupIngressRuleResource
+	framework.WithList
+}
+
+func (l *securityGroupIngressRuleListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = securityGroupRuleListResourceConfigSchema()
+}
+
+func (l *securityGroupIngressRuleListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	listSecurityGroupRuleResources(ctx, &l.securityGroupRuleResource, &l.WithList, false, request, stream)
+}
+
+type securityGroupRuleListModel struct {
+	framework.WithRegionModel
+	SecurityGroupID types.String `tfsdk:"security_group_id"`
+}
+
+func securityGroupRuleListResourceConfigSchema() listschema.Schema {
+	return listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"security_group_id": listschema.StringAttribute{
+				Required:    true,
+				Description: "ID of the security group to list rules from.",
+			},
+		},
+	}
+}
+
+// listSecurityGroupRuleResources streams the ingress or egress rules of a single security group.
+func listSecurityGroupRuleResources(ctx context.Context, r *securityGroupRuleResource, w *framework.WithList, isEgress bool, request list.ListRequest, stream *list.ListResultsStream) {
+	var query securityGroupRuleListModel
+
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	awsClient := r.Meta()
+	conn := awsClient.EC2Client(ctx)
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		result := request.NewListResult(ctx)
+		input := ec2.DescribeSecurityGroupRulesInput{
+			Filters: newAttributeFilterList(map[string]string{
+				"group-id": query.SecurityGroupID.ValueString(),
+			}),
+		}
+		for securityGroupRule, err := range listSecurityGroupRules(ctx, conn, &input) {
+			if err != nil {
+				result = fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			if aws.ToBool(securityGroupRule.IsEgress) != isEgress {
+				continue
+			}
+
+			var data securityGroupRuleResourceModel
+			w.SetResult(ctx, awsClient, &data, &result, func() {
+				data.ID = fwflex.StringToFramework(ctx, securityGroupRule.SecurityGroupRuleId)
+				r.flatten(ctx, &securityGroupRule, &data)
+
+				setTagsOut(ctx, securityGroupRule.Tags)
+
+				ruleID := aws.ToString(securityGroupRule.SecurityGroupRuleId)
+				if v, ok := keyValueTags(ctx, securityGroupRule.Tags)["Name"]; ok {
+					result.DisplayName = fmt.Sprintf("%s (%s)", v.ValueString(), ruleID)
+				} else {
+					result.DisplayName = ruleID
+				}
+			})
+
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+func listSecurityGroupRules(ctx context.Context, conn *ec2.Client, input *ec2.DescribeSecurityGroupRulesInput) iter.Seq2[awstypes.SecurityGroupRule, error] {
+	return func(yield func(awstypes.SecurityGroupRule, error) bool) {
+		pages := ec2.NewDescribeSecurityGroupRulesPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.SecurityGroupRule{}, fmt.Errorf("listing VPC Security Group Rules: %w", err))
+				return
+			}
+
+			for _, securityGroupRule := range page.SecurityGroupRules {
+				if !yield(securityGroupRule, nil) {
+					return
+				}
+			}
+		}
+	}
+}
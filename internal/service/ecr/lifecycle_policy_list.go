@@ -0,0 +1,92 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ecr
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_ecr_lifecycle_policy")
+func lifecyclePolicyResourceAsListResource() inttypes.ListResourceForSDK {
+	l := lifecyclePolicyListResource{}
+	l.SetResourceSchema(resourceLifecyclePolicy())
+	return &l
+}
+
+type lifecyclePolicyListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type lifecyclePolicyListResourceModel struct {
+	framework.WithRegionModel
+}
+
+func (l *lifecyclePolicyListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var query lifecyclePolicyListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	awsClient := l.Meta()
+	conn := awsClient.ECRClient(ctx)
+
+	tflog.Info(ctx, "Listing ECR lifecycle policies")
+	stream.Results = func(yield func(list.ListResult) bool) {
+		var input ecr.DescribeRepositoriesInput
+		for item, err := range listRepositories(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			repositoryName := aws.ToString(item.RepositoryName)
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), repositoryName)
+
+			result := request.NewListResult(ctx)
+			rd := l.ResourceData()
+			rd.SetId(repositoryName)
+
+			diags := resourceLifecyclePolicyRead(ctx, rd, awsClient)
+			if diags.HasError() {
+				tflog.Error(ctx, "Reading ECR lifecycle policy", map[string]any{
+					names.AttrID: repositoryName,
+					"diags":      sdkdiag.DiagnosticsString(diags),
+				})
+				continue
+			}
+
+			// Repository has no lifecycle policy.
+			if rd.Id() == "" {
+				continue
+			}
+
+			result.DisplayName = repositoryName
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2/importer"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -30,7 +31,12 @@ import (
 
 // @SDKResource("aws_ecs_cluster", name="Cluster")
 // @Tags(identifierAttribute="arn")
+// @ArnIdentity
+// @CustomImport
 // @ArnFormat("cluster/{name}")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ecs/types;awstypes;awstypes.Cluster")
+// @Testing(preIdentityVersion="v6.30.0")
+// @Testing(existsTakesT=false, destroyTakesT=false)
 func resourceCluster() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceClusterCreate,
@@ -331,16 +337,23 @@ func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta any
 }
 
 func resourceClusterImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
-	d.Set(names.AttrName, d.Id())
-
-	region := d.Get(names.AttrRegion).(string)
-	d.SetId(arn.ARN{
-		Partition: names.PartitionForRegion(region).ID(),
-		Region:    region,
-		AccountID: meta.(*conns.AWSClient).AccountID(ctx),
-		Service:   "ecs",
-		Resource:  "cluster/" + d.Id(),
-	}.String())
+	// Clusters can also be imported using the cluster name.
+	if id := d.Id(); id != "" && !arn.IsARN(id) {
+		d.Set(names.AttrName, id)
+
+		region := d.Get(names.AttrRegion).(string)
+		d.SetId(arn.ARN{
+			Partition: names.PartitionForRegion(region).ID(),
+			Region:    region,
+			AccountID: meta.(*conns.AWSClient).AccountID(ctx),
+			Service:   "ecs",
+			Resource:  "cluster/" + id,
+		}.String())
+	}
+
+	if err := importer.Import(ctx, d, meta); err != nil {
+		return nil, err
+	}
 
 	return []*schema.ResourceData{d}, nil
 }
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 )
 
 // @FrameworkDataSource("aws_ecs_clusters", name="Clusters")
@@ -48,7 +49,7 @@ func (d *clustersDataSource) Read(ctx context.Context, request datasource.ReadRe
 	conn := d.Meta().ECSClient(ctx)
 
 	input := ecs.ListClustersInput{}
-	arns, err := listClusters(ctx, conn, &input)
+	arns, err := tfslices.CollectWithError(listClusters(ctx, conn, &input))
 
 	if err != nil {
 		response.Diagnostics.AddError("listing ECS Clusters", err.Error())
@@ -60,23 +61,6 @@ func (d *clustersDataSource) Read(ctx context.Context, request datasource.ReadRe
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
 
-func listClusters(ctx context.Context, conn *ecs.Client, input *ecs.ListClustersInput) ([]string, error) {
-	var output []string
-
-	pages := ecs.NewListClustersPaginator(conn, input)
-	for pages.HasMorePages() {
-		page, err := pages.NextPage(ctx)
-
-		if err != nil {
-			return nil, err
-		}
-
-		output = append(output, page.ClusterArns...)
-	}
-
-	return output, nil
-}
-
 type clustersDataSourceModel struct {
 	framework.WithRegionModel
 	ClusterARNs fwtypes.ListOfString `tfsdk:"cluster_arns"`
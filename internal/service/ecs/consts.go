@@ -17,3 +17,11 @@ const (
 	clusterStatusInactive       = "INACTIVE"
 	clusterStatusProvisioning   = "PROVISIONING"
 )
+
+const (
+	deploymentStatusPrimary = "PRIMARY"
+)
+
+const (
+	taskStatusStopped = "STOPPED"
+)
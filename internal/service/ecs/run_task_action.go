@@ -0,0 +1,466 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/actionvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	runTaskPollInterval   = 10 * time.Second
+	runTaskDefaultTimeout = 60 * time.Minute
+)
+
+// @Action(aws_ecs_run_task, name="Run Task")
+func newRunTaskAction(context.Context) (action.ActionWithConfigure, error) {
+	return &runTaskAction{}, nil
+}
+
+var (
+	_ action.Action = (*runTaskAction)(nil)
+)
+
+type runTaskAction struct {
+	framework.ActionWithModel[runTaskActionModel]
+}
+
+type runTaskActionModel struct {
+	framework.WithRegionModel
+	CapacityProviderStrategy fwtypes.ListNestedObjectValueOf[capacityProviderStrategyItemModel] `tfsdk:"capacity_provider_strategy"`
+	Cluster                  types.String                                                       `tfsdk:"cluster"`
+	Group                    types.String                                                       `tfsdk:"group"`
+	LaunchType               fwtypes.StringEnum[awstypes.LaunchType]                            `tfsdk:"launch_type"`
+	NetworkConfiguration     fwtypes.ListNestedObjectValueOf[awsVPCConfigurationModel]          `tfsdk:"network_configuration" autoflex:"-"`
+	Overrides                fwtypes.ListNestedObjectValueOf[taskOverrideModel]                 `tfsdk:"overrides"`
+	PlatformVersion          types.String                                                       `tfsdk:"platform_version"`
+	StartedBy                types.String                                                       `tfsdk:"started_by"`
+	TaskDefinition           types.String                                                       `tfsdk:"task_definition"`
+	Timeout                  types.Int64                                                        `tfsdk:"timeout" autoflex:"-"`
+}
+
+type capacityProviderStrategyItemModel struct {
+	Base             types.Int64  `tfsdk:"base"`
+	CapacityProvider types.String `tfsdk:"capacity_provider"`
+	Weight           types.Int64  `tfsdk:"weight"`
+}
+
+type awsVPCConfigurationModel struct {
+	AssignPublicIP fwtypes.StringEnum[awstypes.AssignPublicIp] `tfsdk:"assign_public_ip"`
+	SecurityGroups fwtypes.ListOfString                        `tfsdk:"security_groups"`
+	Subnets        fwtypes.ListOfString                        `tfsdk:"subnets"`
+}
+
+type taskOverrideModel struct {
+	ContainerOverrides fwtypes.ListNestedObjectValueOf[containerOverrideModel] `tfsdk:"container_overrides"`
+	Cpu                types.String                                            `tfsdk:"cpu"`
+	ExecutionRoleARN   types.String                                            `tfsdk:"execution_role_arn"`
+	Memory             types.String                                            `tfsdk:"memory"`
+	TaskRoleARN        types.String                                            `tfsdk:"task_role_arn"`
+}
+
+type containerOverrideModel struct {
+	Command     fwtypes.ListOfString                               `tfsdk:"command"`
+	Environment fwtypes.ListNestedObjectValueOf[keyValuePairModel] `tfsdk:"environment"`
+	Name        types.String                                       `tfsdk:"name"`
+}
+
+func (a *runTaskAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs a one-off ECS task and waits for it to stop, reporting the exit code of each container.",
+		Attributes: map[string]schema.Attribute{
+			"cluster": schema.StringAttribute{
+				Description: "Name or ARN of the cluster on which to run the task",
+				Required:    true,
+			},
+			"group": schema.StringAttribute{
+				Description: "Name of the task group to associate with the task",
+				Optional:    true,
+			},
+			"launch_type": schema.StringAttribute{
+				CustomType:  fwtypes.StringEnumType[awstypes.LaunchType](),
+				Description: "Infrastructure on which to run the task. Conflicts with capacity_provider_strategy",
+				Optional:    true,
+			},
+			"platform_version": schema.StringAttribute{
+				Description: "Platform version the task uses. Only applicable to Fargate tasks",
+				Optional:    true,
+			},
+			"started_by": schema.StringAttribute{
+				Description: "Optional tag specified when the task is started",
+				Optional:    true,
+			},
+			"task_definition": schema.StringAttribute{
+				Description: "Family and revision (family:revision) or ARN of the task definition to run",
+				Required:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the task to stop (default: 3600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(86400),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrCapacityProviderStrategy: schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[capacityProviderStrategyItemModel](ctx),
+				Description: "Capacity provider strategy to use for the task. Conflicts with launch_type",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(20),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"base": schema.Int64Attribute{
+							Description: "Minimum number of tasks to run on the capacity provider",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 100000),
+							},
+						},
+						"capacity_provider": schema.StringAttribute{
+							Description: "Short name of the capacity provider",
+							Required:    true,
+						},
+						names.AttrWeight: schema.Int64Attribute{
+							Description: "Relative percentage of tasks to run on the capacity provider",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 1000),
+							},
+						},
+					},
+				},
+			},
+			names.AttrNetworkConfiguration: schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[awsVPCConfigurationModel](ctx),
+				Description: "Network configuration for tasks that use the awsvpc network mode",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"assign_public_ip": schema.StringAttribute{
+							CustomType:  fwtypes.StringEnumType[awstypes.AssignPublicIp](),
+							Description: "Whether the task's elastic network interface receives a public IP address",
+							Optional:    true,
+						},
+						names.AttrSecurityGroups: schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							Description: "IDs of the security groups associated with the task",
+							Optional:    true,
+							ElementType: types.StringType,
+						},
+						names.AttrSubnets: schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							Description: "IDs of the subnets associated with the task",
+							Required:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+			"overrides": schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[taskOverrideModel](ctx),
+				Description: "Overrides applied to the task definition",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"cpu": schema.StringAttribute{
+							Description: "CPU override for the task",
+							Optional:    true,
+						},
+						names.AttrExecutionRoleARN: schema.StringAttribute{
+							Description: "ARN of the task execution role override for the task",
+							Optional:    true,
+						},
+						"memory": schema.StringAttribute{
+							Description: "Memory override for the task",
+							Optional:    true,
+						},
+						"task_role_arn": schema.StringAttribute{
+							Description: "ARN of the IAM role that containers in the task can assume",
+							Optional:    true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"container_overrides": schema.ListNestedBlock{
+							CustomType:  fwtypes.NewListNestedObjectTypeOf[containerOverrideModel](ctx),
+							Description: "Overrides sent to containers in the task",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"command": schema.ListAttribute{
+										CustomType:  fwtypes.ListOfStringType,
+										Description: "Command to send to the container that overrides the default command",
+										Optional:    true,
+										ElementType: types.StringType,
+									},
+									names.AttrName: schema.StringAttribute{
+										Description: "Name of the container that receives the override",
+										Required:    true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									names.AttrEnvironment: schema.ListNestedBlock{
+										CustomType:  fwtypes.NewListNestedObjectTypeOf[keyValuePairModel](ctx),
+										Description: "Environment variables to send to the container",
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrName: schema.StringAttribute{
+													Description: "Name of the environment variable",
+													Required:    true,
+												},
+												names.AttrValue: schema.StringAttribute{
+													Description: "Value of the environment variable",
+													Required:    true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *runTaskAction) ConfigValidators(_ context.Context) []action.ConfigValidator {
+	return []action.ConfigValidator{
+		actionvalidator.Conflicting(path.MatchRoot("launch_type"), path.MatchRoot("capacity_provider_strategy")),
+	}
+}
+
+func (a *runTaskAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config runTaskActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().ECSClient(ctx)
+
+	cluster, taskDefinition := config.Cluster.ValueString(), config.TaskDefinition.ValueString()
+
+	timeout := runTaskDefaultTimeout
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Running ECS Task", map[string]any{
+		"cluster":         cluster,
+		"task_definition": taskDefinition,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Running task %s on ECS cluster %s...", taskDefinition, cluster),
+	})
+
+	var input ecs.RunTaskInput
+	resp.Diagnostics.Append(fwflex.Expand(ctx, config, &input)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The network configuration block is flattened into the awsvpc configuration.
+	networkConfiguration, diags := config.NetworkConfiguration.ToPtr(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if networkConfiguration != nil {
+		var awsvpcConfiguration awstypes.AwsVpcConfiguration
+		resp.Diagnostics.Append(fwflex.Expand(ctx, networkConfiguration, &awsvpcConfiguration)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		input.NetworkConfiguration = &awstypes.NetworkConfiguration{
+			AwsvpcConfiguration: &awsvpcConfiguration,
+		}
+	}
+
+	output, err := conn.RunTask(ctx, &input)
+	if err == nil && len(output.Failures) > 0 {
+		err = failureError(&output.Failures[0])
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("running ECS Task (%s)", taskDefinition), err.Error())
+		return
+	}
+
+	task, err := tfresource.AssertSingleValueResult(output.Tasks)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("running ECS Task (%s)", taskDefinition), err.Error())
+		return
+	}
+
+	taskARN := aws.ToString(task.TaskArn)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Task %s started, waiting for it to stop...", taskARN),
+	})
+
+	// The last status is tracked between polls so that each transition is reported exactly once.
+	var lastStatus string
+	fr, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.Task], error) {
+		task, err := findTaskByTwoPartKey(ctx, conn, taskARN, cluster)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.Task]{}, err
+		}
+
+		if status := aws.ToString(task.LastStatus); status != lastStatus {
+			lastStatus = status
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Task %s is %s", taskARN, status),
+			})
+		}
+
+		return actionwait.FetchResult[*awstypes.Task]{Status: actionwait.Status(aws.ToString(task.LastStatus)), Value: task}, nil
+	}, actionwait.Options[*awstypes.Task]{
+		Timeout:  timeout,
+		Interval: actionwait.FixedInterval(runTaskPollInterval),
+		SuccessStates: []actionwait.Status{
+			taskStatusStopped,
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Task",
+				fmt.Sprintf("ECS Task (%s) did not stop within %s (last status: %s). The task has not been stopped.", taskARN, timeout, timeoutErr.LastStatus),
+			)
+		} else {
+			resp.Diagnostics.AddError(fmt.Sprintf("waiting for ECS Task (%s) to stop", taskARN), err.Error())
+		}
+		return
+	}
+
+	task = fr.Value
+
+	// Non-essential containers, such as sidecars, are stopped along with the task and their exit codes don't affect its outcome.
+	taskDefinitionARN := aws.ToString(task.TaskDefinitionArn)
+	definition, _, err := findTaskDefinition(ctx, conn, &ecs.DescribeTaskDefinitionInput{
+		TaskDefinition: aws.String(taskDefinitionARN),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("reading ECS Task Definition (%s)", taskDefinitionARN), err.Error())
+		return
+	}
+
+	essential := make(map[string]bool, len(definition.ContainerDefinitions))
+	for _, v := range definition.ContainerDefinitions {
+		// Containers are essential unless marked otherwise.
+		essential[aws.ToString(v.Name)] = aws.ToBool(v.Essential) || v.Essential == nil
+	}
+
+	failed := task.StopCode != awstypes.TaskStopCodeEssentialContainerExited
+	var sb strings.Builder
+	for _, container := range task.Containers {
+		name := aws.ToString(container.Name)
+
+		if container.ExitCode == nil {
+			if essential[name] {
+				failed = true
+			}
+			fmt.Fprintf(&sb, "\nContainer %s did not exit: %s", name, aws.ToString(container.Reason))
+			continue
+		}
+
+		exitCode := aws.ToInt32(container.ExitCode)
+		if exitCode != 0 && essential[name] {
+			failed = true
+		}
+
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Container %s exited with code %d", name, exitCode),
+		})
+		fmt.Fprintf(&sb, "\nContainer %s exited with code %d", name, exitCode)
+		if v := aws.ToString(container.Reason); v != "" {
+			fmt.Fprintf(&sb, ": %s", v)
+		}
+	}
+
+	if failed {
+		resp.Diagnostics.AddError(
+			"Task Failed",
+			fmt.Sprintf("ECS Task (%s) stopped (%s): %s%s", taskARN, task.StopCode, aws.ToString(task.StoppedReason), sb.String()),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Task %s completed successfully", taskARN),
+	})
+
+	tflog.Info(ctx, "ECS Task completed", map[string]any{
+		"cluster":  cluster,
+		"task_arn": taskARN,
+	})
+}
+
+func findTaskByTwoPartKey(ctx context.Context, conn *ecs.Client, taskARN, clusterNameOrARN string) (*awstypes.Task, error) {
+	input := &ecs.DescribeTasksInput{
+		Cluster: aws.String(clusterNameOrARN),
+		Tasks:   []string{taskARN},
+	}
+
+	output, err := conn.DescribeTasks(ctx, input)
+
+	if errs.IsA[*awstypes.ClusterNotFoundException](err) {
+		return nil, &sdkretry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError()
+	}
+
+	for _, v := range output.Failures {
+		if aws.ToString(v.Reason) == failureReasonMissing {
+			return nil, &sdkretry.NotFoundError{
+				LastError:   failureError(&v),
+				LastRequest: input,
+			}
+		}
+	}
+
+	return tfresource.AssertSingleValueResult(output.Tasks)
+}
@@ -0,0 +1,349 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccECSRunTaskAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRunTaskActionConfig_basic(rName, "exit 0"),
+			},
+		},
+	})
+}
+
+func TestAccECSRunTaskAction_nonZeroExitCode(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRunTaskActionConfig_basic(rName, "exit 3"),
+				ExpectError: regexache.MustCompile(`(?s)Task Failed.*Container main exited with code 3`),
+			},
+		},
+	})
+}
+
+func TestAccECSRunTaskAction_overrides(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				// The overridden command exits with the value of the overridden environment variable.
+				Config:      testAccRunTaskActionConfig_overrides(rName, "5"),
+				ExpectError: regexache.MustCompile(`(?s)Task Failed.*Container main exited with code 5`),
+			},
+		},
+	})
+}
+
+func TestAccECSRunTaskAction_nonEssentialContainerFailure(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRunTaskActionConfig_nonEssentialContainerFailure(rName),
+			},
+		},
+	})
+}
+
+func TestAccECSRunTaskAction_launchTypeConflictsWithCapacityProviderStrategy(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRunTaskActionConfig_launchTypeAndCapacityProviderStrategy(rName),
+				ExpectError: regexache.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func testAccRunTaskActionConfig_base(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 1), fmt.Sprintf(`
+resource "aws_internet_gateway" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route_table" "test" {
+  vpc_id = aws_vpc.test.id
+
+  route {
+    cidr_block = "0.0.0.0/0"
+    gateway_id = aws_internet_gateway.test.id
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route_table_association" "test" {
+  subnet_id      = aws_subnet.test[0].id
+  route_table_id = aws_route_table.test.id
+}
+
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
+
+  egress {
+    from_port   = 0
+    to_port     = 0
+    protocol    = "-1"
+    cidr_blocks = ["0.0.0.0/0"]
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecs_task_definition" "test" {
+  family                   = %[1]q
+  network_mode             = "awsvpc"
+  requires_compatibilities = ["FARGATE"]
+  cpu                      = "256"
+  memory                   = "512"
+
+  container_definitions = jsonencode([
+    {
+      name      = "main"
+      image     = "public.ecr.aws/docker/library/busybox:latest"
+      essential = true
+      command   = ["sh", "-c", "exit 0"]
+    },
+    {
+      name      = "sidecar"
+      image     = "public.ecr.aws/docker/library/busybox:latest"
+      essential = false
+      command   = ["sh", "-c", "sleep 300"]
+    }
+  ])
+}
+`, rName))
+}
+
+func testAccRunTaskActionConfig_basic(rName, command string) string {
+	return acctest.ConfigCompose(testAccRunTaskActionConfig_base(rName), fmt.Sprintf(`
+action "aws_ecs_run_task" "test" {
+  config {
+    cluster         = aws_ecs_cluster.test.name
+    task_definition = aws_ecs_task_definition.test.arn
+    launch_type     = "FARGATE"
+    started_by      = "terraform"
+
+    network_configuration {
+      subnets          = aws_subnet.test[*].id
+      security_groups  = [aws_security_group.test.id]
+      assign_public_ip = "ENABLED"
+    }
+
+    overrides {
+      container_overrides {
+        name    = "main"
+        command = ["sh", "-c", %[1]q]
+      }
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_ecs_task_definition.test.arn
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_ecs_run_task.test]
+    }
+  }
+
+  depends_on = [aws_route_table_association.test]
+}
+`, command))
+}
+
+func testAccRunTaskActionConfig_overrides(rName, exitCode string) string {
+	return acctest.ConfigCompose(testAccRunTaskActionConfig_base(rName), fmt.Sprintf(`
+action "aws_ecs_run_task" "test" {
+  config {
+    cluster         = aws_ecs_cluster.test.name
+    task_definition = aws_ecs_task_definition.test.arn
+    launch_type     = "FARGATE"
+
+    network_configuration {
+      subnets          = aws_subnet.test[*].id
+      security_groups  = [aws_security_group.test.id]
+      assign_public_ip = "ENABLED"
+    }
+
+    overrides {
+      cpu    = "512"
+      memory = "1024"
+
+      container_overrides {
+        name    = "main"
+        command = ["sh", "-c", "exit $EXIT_CODE"]
+
+        environment {
+          name  = "EXIT_CODE"
+          value = %[1]q
+        }
+      }
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_ecs_task_definition.test.arn
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_ecs_run_task.test]
+    }
+  }
+
+  depends_on = [aws_route_table_association.test]
+}
+`, exitCode))
+}
+
+func testAccRunTaskActionConfig_nonEssentialContainerFailure(rName string) string {
+	return acctest.ConfigCompose(testAccRunTaskActionConfig_base(rName), `
+action "aws_ecs_run_task" "test" {
+  config {
+    cluster         = aws_ecs_cluster.test.name
+    task_definition = aws_ecs_task_definition.test.arn
+    launch_type     = "FARGATE"
+
+    network_configuration {
+      subnets          = aws_subnet.test[*].id
+      security_groups  = [aws_security_group.test.id]
+      assign_public_ip = "ENABLED"
+    }
+
+    overrides {
+      container_overrides {
+        name    = "main"
+        command = ["sh", "-c", "sleep 15"]
+      }
+
+      container_overrides {
+        name    = "sidecar"
+        command = ["sh", "-c", "exit 1"]
+      }
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_ecs_task_definition.test.arn
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_ecs_run_task.test]
+    }
+  }
+
+  depends_on = [aws_route_table_association.test]
+}
+`)
+}
+
+func testAccRunTaskActionConfig_launchTypeAndCapacityProviderStrategy(rName string) string {
+	return acctest.ConfigCompose(testAccRunTaskActionConfig_base(rName), `
+action "aws_ecs_run_task" "test" {
+  config {
+    cluster         = aws_ecs_cluster.test.name
+    task_definition = aws_ecs_task_definition.test.arn
+    launch_type     = "FARGATE"
+
+    capacity_provider_strategy {
+      capacity_provider = "FARGATE"
+      weight            = 1
+    }
+
+    network_configuration {
+      subnets          = aws_subnet.test[*].id
+      security_groups  = [aws_security_group.test.id]
+      assign_public_ip = "ENABLED"
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_ecs_task_definition.test.arn
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_ecs_run_task.test]
+    }
+  }
+}
+`)
+}
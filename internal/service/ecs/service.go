@@ -36,6 +36,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2/importer"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2/types/nullable"
@@ -48,6 +49,12 @@ import (
 
 // @SDKResource("aws_ecs_service", name="Service")
 // @Tags(identifierAttribute="arn")
+// @ArnIdentity
+// @CustomImport
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ecs/types;awstypes;awstypes.Service")
+// @Testing(importIgnore="task_definition;wait_for_steady_state")
+// @Testing(preIdentityVersion="v6.30.0")
+// @Testing(existsTakesT=false, destroyTakesT=false)
 func resourceService() *schema.Resource {
 	// Resource with v0 schema (provider v5.58.0).
 	resourceV0 := &schema.Resource{
@@ -1991,34 +1998,62 @@ func resourceServiceDelete(ctx context.Context, d *schema.ResourceData, meta any
 }
 
 func resourceServiceImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
-	parts := strings.Split(d.Id(), "/")
-	if len(parts) != 2 {
-		return []*schema.ResourceData{}, fmt.Errorf("wrong format of resource: %s, expecting 'cluster-name/service-name'", d.Id())
-	}
-	clusterName := parts[0]
-	serviceName := parts[1]
-	log.Printf("[DEBUG] Importing ECS service %s from cluster %s", serviceName, clusterName)
-
-	region := d.Get(names.AttrRegion).(string)
-
-	clusterARN := arn.ARN{
-		Partition: names.PartitionForRegion(region).ID(),
-		Region:    region,
-		Service:   "ecs",
-		AccountID: meta.(*conns.AWSClient).AccountID(ctx),
-		Resource:  fmt.Sprintf("cluster/%s", clusterName),
-	}.String()
-	d.Set("cluster", clusterARN)
-
-	serviceARN := arn.ARN{
-		Partition: names.PartitionForRegion(region).ID(),
-		Region:    region,
-		Service:   "ecs",
-		AccountID: meta.(*conns.AWSClient).AccountID(ctx),
-		Resource:  fmt.Sprintf("service/%s/%s", clusterName, serviceName),
-	}.String()
-	d.SetId(serviceARN)
-	d.Set(names.AttrARN, serviceARN)
+	// Services can also be imported using "cluster-name/service-name".
+	if id := d.Id(); id != "" && !arn.IsARN(id) {
+		parts := strings.Split(id, "/")
+		if len(parts) != 2 {
+			return []*schema.ResourceData{}, fmt.Errorf("wrong format of resource: %s, expecting 'cluster-name/service-name'", id)
+		}
+		clusterName := parts[0]
+		serviceName := parts[1]
+		log.Printf("[DEBUG] Importing ECS service %s from cluster %s", serviceName, clusterName)
+
+		region := d.Get(names.AttrRegion).(string)
+		d.SetId(arn.ARN{
+			Partition: names.PartitionForRegion(region).ID(),
+			Region:    region,
+			Service:   "ecs",
+			AccountID: meta.(*conns.AWSClient).AccountID(ctx),
+			Resource:  fmt.Sprintf("service/%s/%s", clusterName, serviceName),
+		}.String())
+	}
+
+	if err := importer.Import(ctx, d, meta); err != nil {
+		return nil, err
+	}
+
+	serviceARN, err := arn.Parse(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	switch parts := strings.Split(serviceARN.Resource, "/"); {
+	case len(parts) == 3 && parts[0] == "service":
+		// The long ARN format includes the cluster name.
+		d.Set("cluster", arn.ARN{
+			Partition: serviceARN.Partition,
+			Region:    serviceARN.Region,
+			Service:   serviceARN.Service,
+			AccountID: serviceARN.AccountID,
+			Resource:  "cluster/" + parts[1],
+		}.String())
+	case len(parts) == 2 && parts[0] == "service":
+		// The short ARN format, used by accounts that haven't opted in to the long ARN format, doesn't.
+		// Only the default cluster is checked; services in other clusters must be imported using "cluster-name/service-name".
+		conn := meta.(*conns.AWSClient).ECSClient(ctx)
+
+		service, err := findServiceNoTagsByTwoPartKey(ctx, conn, d.Id(), "")
+		if retry.NotFound(err) || (err == nil && aws.ToString(service.Status) != serviceStatusActive) {
+			return nil, fmt.Errorf("ECS Service (%s) not found in the default cluster, import it using 'cluster-name/service-name'", d.Id())
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading ECS Service (%s): %w", d.Id(), err)
+		}
+
+		d.Set("cluster", service.ClusterArn)
+	default:
+		return nil, fmt.Errorf("unexpected format for ID (%q), expected ECS Service ARN", d.Id())
+	}
 
 	return []*schema.ResourceData{d}, nil
 }
@@ -0,0 +1,135 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_ecs_service")
+func serviceResourceAsListResource() inttypes.ListResourceForSDK {
+	l := serviceListResource{}
+	l.SetResourceSchema(resourceService())
+	return &l
+}
+
+var _ list.ListResource = &serviceListResource{}
+
+type serviceListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type serviceListResourceModel struct {
+	framework.WithRegionModel
+	Cluster types.String `tfsdk:"cluster"`
+}
+
+func (l *serviceListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"cluster": listschema.StringAttribute{
+				Required:    true,
+				Description: "Name or ARN of the ECS cluster to list services from.",
+			},
+		},
+	}
+}
+
+func (l *serviceListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var query serviceListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	awsClient := l.Meta()
+	conn := awsClient.ECSClient(ctx)
+
+	cluster := query.Cluster.ValueString()
+
+	tflog.Info(ctx, "Listing ECS services", map[string]any{
+		"cluster": cluster,
+	})
+	stream.Results = func(yield func(list.ListResult) bool) {
+		input := ecs.ListServicesInput{
+			Cluster: aws.String(cluster),
+		}
+		for serviceARN, err := range listServices(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), serviceARN)
+
+			result := request.NewListResult(ctx)
+			rd := l.ResourceData()
+			rd.SetId(serviceARN)
+			rd.Set("cluster", cluster)
+
+			tflog.Info(ctx, "Reading ECS service")
+			diags := resourceServiceRead(ctx, rd, awsClient)
+			if diags.HasError() {
+				tflog.Error(ctx, "Reading ECS service", map[string]any{
+					names.AttrID: serviceARN,
+					"diags":      sdkdiag.DiagnosticsString(diags),
+				})
+				continue
+			}
+			if rd.Id() == "" {
+				// Resource is logically deleted
+				continue
+			}
+
+			result.DisplayName = rd.Get(names.AttrName).(string)
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+func listServices(ctx context.Context, conn *ecs.Client, input *ecs.ListServicesInput) iter.Seq2[string, error] {
+	return func(yield func(string, error) bool) {
+		pages := ecs.NewListServicesPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield("", fmt.Errorf("listing ECS Services: %w", err))
+				return
+			}
+
+			for _, serviceARN := range page.ServiceArns {
+				if !yield(serviceARN, nil) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,189 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_ecs_task_definition")
+func taskDefinitionResourceAsListResource() inttypes.ListResourceForSDK {
+	l := taskDefinitionListResource{}
+	l.SetResourceSchema(resourceTaskDefinition())
+	return &l
+}
+
+var _ list.ListResource = &taskDefinitionListResource{}
+
+type taskDefinitionListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type taskDefinitionListResourceModel struct {
+	framework.WithRegionModel
+	FamilyPrefix types.String `tfsdk:"family_prefix"`
+}
+
+func (l *taskDefinitionListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"family_prefix": listschema.StringAttribute{
+				Optional:    true,
+				Description: "Limits the results to task definitions whose family name begins with the specified prefix.",
+			},
+		},
+	}
+}
+
+func (l *taskDefinitionListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var query taskDefinitionListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	awsClient := l.Meta()
+	conn := awsClient.ECSClient(ctx)
+
+	// Inactive task definitions are treated as deleted by the resource, so only active ones are listed.
+	input := ecs.ListTaskDefinitionsInput{
+		Status: awstypes.TaskDefinitionStatusActive,
+	}
+
+	tflog.Info(ctx, "Listing ECS task definitions", map[string]any{
+		"family_prefix": query.FamilyPrefix.ValueString(),
+	})
+	stream.Results = func(yield func(list.ListResult) bool) {
+		for taskDefinitionARN, err := range listTaskDefinitions(ctx, conn, query.FamilyPrefix.ValueString(), &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrARN), taskDefinitionARN)
+
+			result := request.NewListResult(ctx)
+			rd := l.ResourceData()
+			rd.SetId(taskDefinitionARN)
+			rd.Set(names.AttrARN, taskDefinitionARN)
+
+			tflog.Info(ctx, "Reading ECS task definition")
+			diags := resourceTaskDefinitionRead(ctx, rd, awsClient)
+			if diags.HasError() {
+				tflog.Error(ctx, "Reading ECS task definition", map[string]any{
+					names.AttrARN: taskDefinitionARN,
+					"diags":       sdkdiag.DiagnosticsString(diags),
+				})
+				continue
+			}
+			if rd.Id() == "" {
+				// Resource is logically deleted
+				continue
+			}
+
+			result.DisplayName = taskDefinitionDisplayName(taskDefinitionARN)
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+// taskDefinitionDisplayName returns the "family:revision" portion of a task definition ARN.
+func taskDefinitionDisplayName(taskDefinitionARN string) string {
+	v, err := arn.Parse(taskDefinitionARN)
+	if err != nil {
+		return taskDefinitionARN
+	}
+
+	return strings.TrimPrefix(v.Resource, "task-definition/")
+}
+
+// listTaskDefinitions returns the ARNs of the task definitions matching the input.
+// ListTaskDefinitions only matches complete family names, so when a family name prefix is specified
+// the matching families are found first and the task definitions in each family listed in turn.
+func listTaskDefinitions(ctx context.Context, conn *ecs.Client, familyPrefix string, input *ecs.ListTaskDefinitionsInput) iter.Seq2[string, error] {
+	return func(yield func(string, error) bool) {
+		if familyPrefix == "" {
+			for taskDefinitionARN, err := range listTaskDefinitionARNs(ctx, conn, input) {
+				if !yield(taskDefinitionARN, err) || err != nil {
+					return
+				}
+			}
+
+			return
+		}
+
+		familiesInput := ecs.ListTaskDefinitionFamiliesInput{
+			FamilyPrefix: aws.String(familyPrefix),
+			Status:       awstypes.TaskDefinitionFamilyStatusAll,
+		}
+		pages := ecs.NewListTaskDefinitionFamiliesPaginator(conn, &familiesInput)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield("", fmt.Errorf("listing ECS Task Definition Families: %w", err))
+				return
+			}
+
+			for _, family := range page.Families {
+				input := *input
+				input.FamilyPrefix = aws.String(family)
+
+				for taskDefinitionARN, err := range listTaskDefinitionARNs(ctx, conn, &input) {
+					if !yield(taskDefinitionARN, err) || err != nil {
+						return
+					}
+				}
+			}
+		}
+	}
+}
+
+func listTaskDefinitionARNs(ctx context.Context, conn *ecs.Client, input *ecs.ListTaskDefinitionsInput) iter.Seq2[string, error] {
+	return func(yield func(string, error) bool) {
+		pages := ecs.NewListTaskDefinitionsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield("", fmt.Errorf("listing ECS Task Definitions: %w", err))
+				return
+			}
+
+			for _, taskDefinitionARN := range page.TaskDefinitionArns {
+				if !yield(taskDefinitionARN, nil) {
+					return
+				}
+			}
+		}
+	}
+}
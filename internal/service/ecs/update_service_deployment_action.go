@@ -0,0 +1,224 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	updateServiceDeploymentPollInterval   = 15 * time.Second
+	updateServiceDeploymentDefaultTimeout = 30 * time.Minute
+)
+
+// @Action(aws_ecs_update_service_deployment, name="Update Service Deployment")
+func newUpdateServiceDeploymentAction(context.Context) (action.ActionWithConfigure, error) {
+	return &updateServiceDeploymentAction{}, nil
+}
+
+var (
+	_ action.Action = (*updateServiceDeploymentAction)(nil)
+)
+
+type updateServiceDeploymentAction struct {
+	framework.ActionWithModel[updateServiceDeploymentActionModel]
+}
+
+type updateServiceDeploymentActionModel struct {
+	framework.WithRegionModel
+	Cluster            types.String `tfsdk:"cluster"`
+	ForceNewDeployment types.Bool   `tfsdk:"force_new_deployment"`
+	Service            types.String `tfsdk:"service"`
+	TaskDefinition     types.String `tfsdk:"task_definition"`
+	Timeout            types.Int64  `tfsdk:"timeout"`
+	WaitForSteadyState types.Bool   `tfsdk:"wait_for_steady_state"`
+}
+
+func (a *updateServiceDeploymentAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts a new deployment of an ECS service and optionally waits for the deployment to complete.",
+		Attributes: map[string]schema.Attribute{
+			"cluster": schema.StringAttribute{
+				Description: "Name or ARN of the cluster that hosts the service",
+				Required:    true,
+			},
+			"force_new_deployment": schema.BoolAttribute{
+				Description: "Whether to force a new deployment even if the service definition is unchanged (default: true)",
+				Optional:    true,
+			},
+			"service": schema.StringAttribute{
+				Description: "Name or ARN of the service to deploy",
+				Required:    true,
+			},
+			"task_definition": schema.StringAttribute{
+				Description: "Family and revision (family:revision) or ARN of the task definition to deploy. Defaults to the service's current task definition",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the deployment to complete (default: 1800)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(86400),
+				},
+			},
+			"wait_for_steady_state": schema.BoolAttribute{
+				Description: "Whether to wait until the PRIMARY deployment's rollout state is COMPLETED (default: false)",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (a *updateServiceDeploymentAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config updateServiceDeploymentActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().ECSClient(ctx)
+
+	cluster, service := config.Cluster.ValueString(), config.Service.ValueString()
+
+	timeout := updateServiceDeploymentDefaultTimeout
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting ECS Service deployment", map[string]any{
+		"cluster":         cluster,
+		"service":         service,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Starting new deployment of ECS service %s...", service),
+	})
+
+	input := ecs.UpdateServiceInput{
+		Cluster:            aws.String(cluster),
+		ForceNewDeployment: config.ForceNewDeployment.IsNull() || config.ForceNewDeployment.ValueBool(),
+		Service:            aws.String(service),
+		TaskDefinition:     config.TaskDefinition.ValueStringPointer(),
+	}
+
+	output, err := conn.UpdateService(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("updating ECS Service (%s)", service), err.Error())
+		return
+	}
+
+	primary, err := tfresource.AssertSingleValueResult(tfslices.Filter(output.Service.Deployments, func(v awstypes.Deployment) bool {
+		return aws.ToString(v.Status) == deploymentStatusPrimary
+	}))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("reading ECS Service (%s) PRIMARY deployment", service), err.Error())
+		return
+	}
+
+	deploymentID := aws.ToString(primary.Id)
+
+	if !config.WaitForSteadyState.ValueBool() {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Deployment %s of ECS service %s started", deploymentID, service),
+		})
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Deployment %s started, waiting for rollout to complete...", deploymentID),
+	})
+
+	// Deployment counts are tracked between polls so that each change is reported exactly once.
+	var lastProgress string
+	fr, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.Deployment], error) {
+		output, err := findServiceNoTagsByTwoPartKey(ctx, conn, service, cluster)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.Deployment]{}, err
+		}
+
+		deployment, err := tfresource.AssertSingleValueResult(tfslices.Filter(output.Deployments, func(v awstypes.Deployment) bool {
+			return aws.ToString(v.Id) == deploymentID
+		}))
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.Deployment]{}, fmt.Errorf("deployment %s is no longer active: %w", deploymentID, err)
+		}
+
+		if status := aws.ToString(deployment.Status); status != deploymentStatusPrimary {
+			return actionwait.FetchResult[*awstypes.Deployment]{}, fmt.Errorf("deployment %s was superseded by a newer deployment (status: %s)", deploymentID, status)
+		}
+
+		if progress := fmt.Sprintf("Deployment %s is %s: %d running, %d pending, %d desired, %d failed", deploymentID, deployment.RolloutState, deployment.RunningCount, deployment.PendingCount, deployment.DesiredCount, deployment.FailedTasks); progress != lastProgress {
+			lastProgress = progress
+			resp.SendProgress(action.InvokeProgressEvent{Message: progress})
+		}
+
+		return actionwait.FetchResult[*awstypes.Deployment]{Status: actionwait.Status(deployment.RolloutState), Value: deployment}, nil
+	}, actionwait.Options[*awstypes.Deployment]{
+		Timeout:  timeout,
+		Interval: actionwait.FixedInterval(updateServiceDeploymentPollInterval),
+		SuccessStates: []actionwait.Status{
+			actionwait.Status(awstypes.DeploymentRolloutStateCompleted),
+		},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.DeploymentRolloutStateInProgress),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.DeploymentRolloutStateFailed),
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		var unexpectedErr *actionwait.UnexpectedStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Deployment",
+				fmt.Sprintf("ECS Service (%s) deployment %s did not complete within %s (last rollout state: %s)", service, deploymentID, timeout, timeoutErr.LastStatus),
+			)
+		} else if errors.As(err, &failureErr) {
+			resp.Diagnostics.AddError(
+				"Deployment Failed",
+				fmt.Sprintf("ECS Service (%s) deployment %s failed: %s", service, deploymentID, aws.ToString(fr.Value.RolloutStateReason)),
+			)
+		} else if errors.As(err, &unexpectedErr) {
+			resp.Diagnostics.AddError(
+				"Unexpected Deployment Rollout State",
+				fmt.Sprintf("ECS Service (%s) deployment %s does not report a rollout state that can be waited on (%s). Waiting is only supported for services that use the ECS deployment controller.", service, deploymentID, err),
+			)
+		} else {
+			resp.Diagnostics.AddError(fmt.Sprintf("waiting for ECS Service (%s) deployment %s", service, deploymentID), err.Error())
+		}
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Deployment %s of ECS service %s completed with %d running task(s)", deploymentID, service, fr.Value.RunningCount),
+	})
+
+	tflog.Info(ctx, "ECS Service deployment completed", map[string]any{
+		"cluster":       cluster,
+		"service":       service,
+		"deployment_id": deploymentID,
+	})
+}
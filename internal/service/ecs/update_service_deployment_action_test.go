@@ -0,0 +1,155 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfecs "github.com/hashicorp/terraform-provider-aws/internal/service/ecs"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccECSUpdateServiceDeploymentAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var service awstypes.Service
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_ecs_service.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceDestroy(ctx),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUpdateServiceDeploymentActionConfig_base(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(ctx, resourceName, &service),
+				),
+			},
+			{
+				Config: testAccUpdateServiceDeploymentActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServicePrimaryDeploymentReplaced(ctx, t, resourceName, &service),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckServicePrimaryDeploymentReplaced verifies that the service's PRIMARY deployment differs from the
+// PRIMARY deployment of the previously read service and that its rollout has completed.
+func testAccCheckServicePrimaryDeploymentReplaced(ctx context.Context, t *testing.T, n string, before *awstypes.Service) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).ECSClient(ctx)
+
+		after, err := tfecs.FindServiceNoTagsByTwoPartKey(ctx, conn, rs.Primary.ID, rs.Primary.Attributes["cluster"])
+
+		if err != nil {
+			return err
+		}
+
+		primary := func(service *awstypes.Service) *awstypes.Deployment {
+			for _, v := range service.Deployments {
+				if aws.ToString(v.Status) == "PRIMARY" {
+					return &v
+				}
+			}
+			return nil
+		}
+
+		b, a := primary(before), primary(after)
+		if b == nil || a == nil {
+			return fmt.Errorf("ECS Service (%s) has no PRIMARY deployment", rs.Primary.ID)
+		}
+
+		if aws.ToString(a.Id) == aws.ToString(b.Id) {
+			return fmt.Errorf("ECS Service (%s) PRIMARY deployment was not replaced", rs.Primary.ID)
+		}
+
+		if a.RolloutState != awstypes.DeploymentRolloutStateCompleted {
+			return fmt.Errorf("ECS Service (%s) PRIMARY deployment rollout state = %s, want %s", rs.Primary.ID, a.RolloutState, awstypes.DeploymentRolloutStateCompleted)
+		}
+
+		return nil
+	}
+}
+
+func testAccUpdateServiceDeploymentActionConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccRunTaskActionConfig_base(rName), fmt.Sprintf(`
+resource "aws_ecs_task_definition" "service" {
+  family                   = "%[1]s-service"
+  network_mode             = "awsvpc"
+  requires_compatibilities = ["FARGATE"]
+  cpu                      = "256"
+  memory                   = "512"
+
+  container_definitions = jsonencode([
+    {
+      name      = "main"
+      image     = "public.ecr.aws/docker/library/busybox:latest"
+      essential = true
+      command   = ["sleep", "3600"]
+    }
+  ])
+}
+
+resource "aws_ecs_service" "test" {
+  name            = %[1]q
+  cluster         = aws_ecs_cluster.test.id
+  task_definition = aws_ecs_task_definition.service.arn
+  desired_count   = 1
+  launch_type     = "FARGATE"
+
+  network_configuration {
+    subnets          = aws_subnet.test[*].id
+    security_groups  = [aws_security_group.test.id]
+    assign_public_ip = true
+  }
+
+  wait_for_steady_state = true
+
+  depends_on = [aws_route_table_association.test]
+}
+`, rName))
+}
+
+func testAccUpdateServiceDeploymentActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccUpdateServiceDeploymentActionConfig_base(rName), `
+action "aws_ecs_update_service_deployment" "test" {
+  config {
+    cluster               = aws_ecs_cluster.test.name
+    service               = aws_ecs_service.test.name
+    wait_for_steady_state = true
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_ecs_service.test.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_ecs_update_service_deployment.test]
+    }
+  }
+}
+`)
+}
@@ -31,6 +31,7 @@ var (
 	WaitCacheClusterDeleted              = waitCacheClusterDeleted
 	WaitReplicationGroupAvailable        = waitReplicationGroupAvailable
 
+	BuildIAMAuthToken                                 = buildIAMAuthToken
 	DeleteCacheCluster                                = deleteCacheCluster
 	DiffVersion                                       = diffVersion
 	EmptyDescription                                  = emptyDescription
@@ -0,0 +1,145 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package elasticache
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+)
+
+const (
+	// IAM authentication tokens are valid for 15 minutes.
+	iamAuthTokenTTL = 15 * time.Minute
+
+	// SHA-256 hash of an empty request payload.
+	iamAuthTokenEmptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+)
+
+// @EphemeralResource(aws_elasticache_iam_auth_token, name="IAM Auth Token")
+func newIAMAuthTokenEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &iamAuthTokenEphemeralResource{}, nil
+}
+
+type iamAuthTokenEphemeralResource struct {
+	framework.EphemeralResourceWithModel[iamAuthTokenEphemeralResourceModel]
+}
+
+func (e *iamAuthTokenEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"expiration": schema.StringAttribute{
+				Computed: true,
+			},
+			"replication_group_id": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(
+						path.MatchRoot("replication_group_id"),
+						path.MatchRoot("serverless_cache_name"),
+					),
+				},
+			},
+			"serverless_cache_name": schema.StringAttribute{
+				Optional: true,
+			},
+			"token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"user_id": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (e *iamAuthTokenEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	data := iamAuthTokenEphemeralResourceModel{}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	cacheName, serverless := data.ReplicationGroupID.ValueString(), false
+	if !data.ServerlessCacheName.IsNull() {
+		cacheName, serverless = data.ServerlessCacheName.ValueString(), true
+	}
+
+	signingTime := time.Now()
+	token, err := buildIAMAuthToken(ctx, e.Meta().CredentialsProvider(ctx), e.Meta().Region(ctx), cacheName, data.UserID.ValueString(), serverless, signingTime)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, cacheName)
+		return
+	}
+
+	data.Expiration = types.StringValue(signingTime.Add(iamAuthTokenTTL).UTC().Format(time.RFC3339))
+	data.Token = types.StringValue(token)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.Result.Set(ctx, &data))
+}
+
+type iamAuthTokenEphemeralResourceModel struct {
+	framework.WithRegionModel
+	Expiration          types.String `tfsdk:"expiration"`
+	ReplicationGroupID  types.String `tfsdk:"replication_group_id"`
+	ServerlessCacheName types.String `tfsdk:"serverless_cache_name"`
+	Token               types.String `tfsdk:"token"`
+	UserID              types.String `tfsdk:"user_id"`
+}
+
+// buildIAMAuthToken returns a SigV4 presigned connect request for the specified cache and user.
+// See https://docs.aws.amazon.com/AmazonElastiCache/latest/dg/auth-iam.html.
+func buildIAMAuthToken(ctx context.Context, credentialsProvider aws.CredentialsProvider, region, cacheName, userID string, serverless bool, signingTime time.Time) (string, error) {
+	if credentialsProvider == nil {
+		return "", fmt.Errorf("no AWS credentials configured")
+	}
+
+	credentials, err := credentialsProvider.Retrieve(ctx)
+	if err != nil {
+		return "", fmt.Errorf("retrieving AWS credentials: %w", err)
+	}
+
+	query := url.Values{}
+	query.Set("Action", "connect")
+	query.Set("User", userID)
+	if serverless {
+		query.Set("ResourceType", "ServerlessCache")
+	}
+	query.Set("X-Amz-Expires", strconv.FormatInt(int64(iamAuthTokenTTL/time.Second), 10))
+
+	endpoint := url.URL{
+		Scheme:   "http",
+		Host:     cacheName,
+		Path:     "/",
+		RawQuery: query.Encode(),
+	}
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint.String(), nil)
+	if err != nil {
+		return "", err
+	}
+
+	signedURI, _, err := v4.NewSigner().PresignHTTP(ctx, credentials, httpRequest, iamAuthTokenEmptyPayloadHash, "elasticache", region, signingTime)
+	if err != nil {
+		return "", fmt.Errorf("signing IAM auth token: %w", err)
+	}
+
+	return strings.TrimPrefix(signedURI, endpoint.Scheme+"://"), nil
+}
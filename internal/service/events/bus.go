@@ -29,6 +29,12 @@ import (
 
 // @SDKResource("aws_cloudwatch_event_bus", name="Event Bus")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("name")
+// @Testing(name="Bus")
+// @Testing(preIdentityVersion="v6.30.0")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/eventbridge;eventbridge.DescribeEventBusOutput")
+// @Testing(idAttrDuplicates="name")
+// @Testing(existsTakesT=false, destroyTakesT=false)
 func resourceBus() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceBusCreate,
@@ -36,10 +42,6 @@ func resourceBus() *schema.Resource {
 		UpdateWithoutTimeout: resourceBusUpdate,
 		DeleteWithoutTimeout: resourceBusDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -0,0 +1,156 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package events
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_cloudwatch_event_bus")
+func busResourceAsListResource() inttypes.ListResourceForSDK {
+	l := busListResource{}
+	l.SetResourceSchema(resourceBus())
+	return &l
+}
+
+type busListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type busListResourceModel struct {
+	framework.WithRegionModel
+	NamePrefix fwtypes.String `tfsdk:"name_prefix"`
+	Tags       tftags.Map     `tfsdk:"tags"`
+}
+
+func (l *busListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			names.AttrNamePrefix: listschema.StringAttribute{
+				Optional:    true,
+				Description: "Limits the results to event buses whose name begins with the specified prefix.",
+			},
+			names.AttrTags: listschema.MapAttribute{
+				CustomType:  tftags.MapType,
+				ElementType: fwtypes.StringType,
+				Optional:    true,
+				Description: "Map of tags, each pair of which must exactly match a pair on the desired event buses.",
+			},
+		},
+	}
+}
+
+func (l *busListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var query busListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	awsClient := l.Meta()
+	conn := awsClient.EventsClient(ctx)
+
+	filterTags := tftags.New(ctx, query.Tags)
+
+	tflog.Info(ctx, "Listing EventBridge event buses")
+	stream.Results = func(yield func(list.ListResult) bool) {
+		input := eventbridge.ListEventBusesInput{
+			NamePrefix: query.NamePrefix.ValueStringPointer(),
+		}
+		for item, err := range listBuses(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			name := aws.ToString(item.Name)
+
+			// The default event bus always exists and can't be managed.
+			if name == DefaultEventBusName {
+				continue
+			}
+
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), name)
+
+			if len(filterTags) > 0 {
+				tags, err := listTags(ctx, conn, aws.ToString(item.Arn))
+				if err != nil {
+					result := fwdiag.NewListResultErrorDiagnostic(fmt.Errorf("listing tags for EventBridge Event Bus (%s): %w", name, err))
+					yield(result)
+					return
+				}
+				if !tags.ContainsAll(filterTags) {
+					continue
+				}
+			}
+
+			result := request.NewListResult(ctx)
+			rd := l.ResourceData()
+			rd.SetId(name)
+
+			diags := resourceBusRead(ctx, rd, awsClient)
+			if diags.HasError() || rd.Id() == "" {
+				// Resource can't be read or is logically deleted.
+				// Log and continue.
+				tflog.Error(ctx, "Reading EventBridge event bus", map[string]any{
+					names.AttrID: name,
+					"diags":      sdkdiag.DiagnosticsString(diags),
+				})
+				continue
+			}
+
+			result.DisplayName = name
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+func listBuses(ctx context.Context, conn *eventbridge.Client, input *eventbridge.ListEventBusesInput) iter.Seq2[types.EventBus, error] {
+	return func(yield func(types.EventBus, error) bool) {
+		var stopped bool
+		err := listEventBusesPages(ctx, conn, input, func(page *eventbridge.ListEventBusesOutput, lastPage bool) bool {
+			for _, bus := range page.EventBuses {
+				if !yield(bus, nil) {
+					stopped = true
+					return false
+				}
+			}
+
+			return !lastPage
+		})
+
+		if err != nil && !stopped {
+			yield(types.EventBus{}, fmt.Errorf("listing EventBridge Event Buses: %w", err))
+		}
+	}
+}
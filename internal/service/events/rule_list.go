@@ -0,0 +1,163 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package events
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_cloudwatch_event_rule")
+func ruleResourceAsListResource() inttypes.ListResourceForSDK {
+	l := ruleListResource{}
+	l.SetResourceSchema(resourceRule())
+	return &l
+}
+
+type ruleListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type ruleListResourceModel struct {
+	framework.WithRegionModel
+	EventBusName fwtypes.String `tfsdk:"event_bus_name"`
+	NamePrefix   fwtypes.String `tfsdk:"name_prefix"`
+	Tags         tftags.Map     `tfsdk:"tags"`
+}
+
+func (l *ruleListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"event_bus_name": listschema.StringAttribute{
+				Optional:    true,
+				Description: "Name or ARN of the event bus to list rules for. Defaults to the default event bus.",
+			},
+			names.AttrNamePrefix: listschema.StringAttribute{
+				Optional:    true,
+				Description: "Limits the results to rules whose name begins with the specified prefix.",
+			},
+			names.AttrTags: listschema.MapAttribute{
+				CustomType:  tftags.MapType,
+				ElementType: fwtypes.StringType,
+				Optional:    true,
+				Description: "Map of tags, each pair of which must exactly match a pair on the desired rules.",
+			},
+		},
+	}
+}
+
+func (l *ruleListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var query ruleListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	awsClient := l.Meta()
+	conn := awsClient.EventsClient(ctx)
+
+	eventBusName := DefaultEventBusName
+	if v := query.EventBusName.ValueString(); v != "" {
+		eventBusName = v
+	}
+	filterTags := tftags.New(ctx, query.Tags)
+
+	tflog.Info(ctx, "Listing EventBridge rules", map[string]any{
+		"event_bus_name": eventBusName,
+	})
+	stream.Results = func(yield func(list.ListResult) bool) {
+		input := eventbridge.ListRulesInput{
+			EventBusName: aws.String(eventBusName),
+			NamePrefix:   query.NamePrefix.ValueStringPointer(),
+		}
+		for item, err := range listRules(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			ruleName := aws.ToString(item.Name)
+			id := ruleCreateResourceID(eventBusName, ruleName)
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), id)
+
+			if len(filterTags) > 0 {
+				tags, err := listTags(ctx, conn, aws.ToString(item.Arn))
+				if err != nil {
+					result := fwdiag.NewListResultErrorDiagnostic(fmt.Errorf("listing tags for EventBridge Rule (%s): %w", id, err))
+					yield(result)
+					return
+				}
+				if !tags.ContainsAll(filterTags) {
+					continue
+				}
+			}
+
+			result := request.NewListResult(ctx)
+			rd := l.ResourceData()
+			rd.SetId(id)
+
+			diags := resourceRuleRead(ctx, rd, awsClient)
+			if diags.HasError() || rd.Id() == "" {
+				// Resource can't be read or is logically deleted.
+				// Log and continue.
+				tflog.Error(ctx, "Reading EventBridge rule", map[string]any{
+					names.AttrID: id,
+					"diags":      sdkdiag.DiagnosticsString(diags),
+				})
+				continue
+			}
+
+			result.DisplayName = ruleName
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+func listRules(ctx context.Context, conn *eventbridge.Client, input *eventbridge.ListRulesInput) iter.Seq2[types.Rule, error] {
+	return func(yield func(types.Rule, error) bool) {
+		var stopped bool
+		err := listRulesPages(ctx, conn, input, func(page *eventbridge.ListRulesOutput, lastPage bool) bool {
+			for _, rule := range page.Rules {
+				if !yield(rule, nil) {
+					stopped = true
+					return false
+				}
+			}
+
+			return !lastPage
+		})
+
+		if err != nil && !stopped {
+			yield(types.Rule{}, fmt.Errorf("listing EventBridge Rules: %w", err))
+		}
+	}
+}
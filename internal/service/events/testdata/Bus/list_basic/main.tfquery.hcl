@@ -0,0 +1,10 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+list "aws_cloudwatch_event_bus" "test" {
+  provider = aws
+
+  config {
+    name_prefix = var.rName
+  }
+}
@@ -0,0 +1,22 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_cloudwatch_event_rule" "test" {
+  count = 2
+
+  name           = "${var.rName}-${count.index}"
+  event_bus_name = aws_cloudwatch_event_bus.test.name
+  event_pattern = jsonencode({
+    source = ["aws.ec2"]
+  })
+}
+
+resource "aws_cloudwatch_event_bus" "test" {
+  name = var.rName
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
@@ -0,0 +1,195 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package glue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/glue/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	startCrawlerPollInterval   = 15 * time.Second
+	startCrawlerDefaultTimeout = 60 * time.Minute
+)
+
+// crawlerStatusStarting is reported while the crawler is READY but has not yet recorded the crawl started by the action.
+const crawlerStatusStarting actionwait.Status = "STARTING"
+
+// @Action(aws_glue_start_crawler, name="Start Crawler")
+func newStartCrawlerAction(context.Context) (action.ActionWithConfigure, error) {
+	return &startCrawlerAction{}, nil
+}
+
+var (
+	_ action.Action = (*startCrawlerAction)(nil)
+)
+
+type startCrawlerAction struct {
+	framework.ActionWithModel[startCrawlerActionModel]
+}
+
+type startCrawlerActionModel struct {
+	framework.WithRegionModel
+	CrawlerName types.String `tfsdk:"crawler_name"`
+	Timeout     types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *startCrawlerAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts a Glue crawler and waits for the crawl to complete.",
+		Attributes: map[string]schema.Attribute{
+			"crawler_name": schema.StringAttribute{
+				Description: "Name of the crawler to start",
+				Required:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the crawl to complete (default: 3600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(86400),
+				},
+			},
+		},
+	}
+}
+
+func (a *startCrawlerAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config startCrawlerActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().GlueClient(ctx)
+
+	crawlerName := config.CrawlerName.ValueString()
+
+	timeout := startCrawlerDefaultTimeout
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting Glue crawler", map[string]any{
+		"crawler_name":    crawlerName,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Starting Glue crawler %s...", crawlerName),
+	})
+
+	// The start time of the previous crawl identifies when the crawl started by this action has finished.
+	crawler, err := findCrawlerByName(ctx, conn, crawlerName)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("reading Glue Crawler (%s)", crawlerName), err.Error())
+		return
+	}
+	var previousStartTime time.Time
+	if v := crawler.LastCrawl; v != nil {
+		previousStartTime = aws.ToTime(v.StartTime)
+	}
+
+	input := glue.StartCrawlerInput{
+		Name: aws.String(crawlerName),
+	}
+	if _, err := conn.StartCrawler(ctx, &input); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("starting Glue Crawler (%s)", crawlerName), err.Error())
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Glue crawler %s started, waiting for the crawl to complete...", crawlerName),
+	})
+
+	// Crawler state is tracked between polls so that each transition is reported exactly once.
+	var lastState actionwait.Status
+	fr, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.Crawler], error) {
+		output, err := findCrawlerByName(ctx, conn, crawlerName)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.Crawler]{}, err
+		}
+
+		state := actionwait.Status(output.State)
+		// The crawler can still be READY immediately after StartCrawler returns.
+		// It has only finished once it is READY with a crawl newer than the one recorded before the call.
+		if output.State == awstypes.CrawlerStateReady && (output.LastCrawl == nil || !aws.ToTime(output.LastCrawl.StartTime).After(previousStartTime)) {
+			state = crawlerStatusStarting
+		}
+
+		if state != lastState {
+			lastState = state
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Glue crawler %s is %s", crawlerName, state),
+			})
+		}
+
+		return actionwait.FetchResult[*awstypes.Crawler]{Status: state, Value: output}, nil
+	}, actionwait.Options[*awstypes.Crawler]{
+		Timeout:  timeout,
+		Interval: actionwait.FixedInterval(startCrawlerPollInterval),
+		SuccessStates: []actionwait.Status{
+			actionwait.Status(awstypes.CrawlerStateReady),
+		},
+		TransitionalStates: []actionwait.Status{
+			crawlerStatusStarting,
+			actionwait.Status(awstypes.CrawlerStateRunning),
+			actionwait.Status(awstypes.CrawlerStateStopping),
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Crawler",
+				fmt.Sprintf("Glue Crawler (%s) did not complete within %s (last state: %s)", crawlerName, timeout, timeoutErr.LastStatus),
+			)
+		} else {
+			resp.Diagnostics.AddError(fmt.Sprintf("waiting for Glue Crawler (%s)", crawlerName), err.Error())
+		}
+		return
+	}
+
+	lastCrawl := fr.Value.LastCrawl
+	if lastCrawl == nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("reading Glue Crawler (%s) last crawl", crawlerName), "no last crawl information available")
+		return
+	}
+
+	if lastCrawl.Status != awstypes.LastCrawlStatusSucceeded {
+		message := aws.ToString(lastCrawl.ErrorMessage)
+		if message == "" {
+			message = "no error message available"
+		}
+		resp.Diagnostics.AddError(
+			"Crawl Failed",
+			fmt.Sprintf("Glue Crawler (%s) last crawl %s: %s", crawlerName, lastCrawl.Status, message),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Glue crawler %s completed, last crawl status: %s", crawlerName, lastCrawl.Status),
+	})
+
+	tflog.Info(ctx, "Glue crawler completed", map[string]any{
+		"crawler_name":      crawlerName,
+		"last_crawl_status": lastCrawl.Status,
+	})
+}
@@ -0,0 +1,178 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package glue_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccGlueStartCrawlerAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	dataSourceName := "data.aws_glue_catalog_table.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlueServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartCrawlerActionConfig_basic(rName),
+			},
+			{
+				// The crawler creates a table named after the S3 prefix it crawled.
+				Config: acctest.ConfigCompose(testAccStartCrawlerActionConfig_basic(rName), `
+data "aws_glue_catalog_table" "test" {
+  database_name = aws_glue_catalog_database.test.name
+  name          = "data"
+
+  depends_on = [terraform_data.trigger]
+}
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "storage_descriptor.0.columns.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGlueStartCrawlerAction_notFound(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlueServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStartCrawlerActionConfig_notFound(rName),
+				ExpectError: regexache.MustCompile(`reading Glue Crawler`),
+			},
+		},
+	})
+}
+
+func testAccStartCrawlerActionConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_iam_policy_document" "assume" {
+  statement {
+    actions = ["sts:AssumeRole"]
+
+    principals {
+      type        = "Service"
+      identifiers = ["glue.${data.aws_partition.current.dns_suffix}"]
+    }
+  }
+}
+
+resource "aws_iam_role" "test" {
+  name               = %[1]q
+  assume_role_policy = data.aws_iam_policy_document.assume.json
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AWSGlueServiceRole"
+  role       = aws_iam_role.test.name
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.name
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = ["s3:GetObject", "s3:ListBucket"]
+      Resource = [aws_s3_bucket.test.arn, "${aws_s3_bucket.test.arn}/*"]
+    }]
+  })
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "data/part-0.csv"
+  content = "id,name\n1,first\n2,second\n"
+}
+
+resource "aws_glue_catalog_database" "test" {
+  name = %[2]q
+}
+
+resource "aws_glue_crawler" "test" {
+  name          = %[1]q
+  database_name = aws_glue_catalog_database.test.name
+  role          = aws_iam_role.test.arn
+
+  s3_target {
+    path = "s3://${aws_s3_bucket.test.bucket}/data/"
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test, aws_iam_role_policy.test]
+}
+
+action "aws_glue_start_crawler" "test" {
+  config {
+    crawler_name = aws_glue_crawler.test.name
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_glue_crawler.test.name
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_glue_start_crawler.test]
+    }
+  }
+
+  depends_on = [aws_s3_object.test]
+}
+`, rName, strings.ReplaceAll(rName, "-", "_"))
+}
+
+func testAccStartCrawlerActionConfig_notFound(rName string) string {
+	return fmt.Sprintf(`
+action "aws_glue_start_crawler" "test" {
+  config {
+    crawler_name = %[1]q
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = %[1]q
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_glue_start_crawler.test]
+    }
+  }
+}
+`, rName)
+}
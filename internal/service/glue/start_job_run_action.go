@@ -0,0 +1,253 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package glue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/glue/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	startJobRunPollInterval   = 30 * time.Second
+	startJobRunDefaultTimeout = 60 * time.Minute
+)
+
+// @Action(aws_glue_start_job_run, name="Start Job Run")
+func newStartJobRunAction(context.Context) (action.ActionWithConfigure, error) {
+	return &startJobRunAction{}, nil
+}
+
+var (
+	_ action.Action = (*startJobRunAction)(nil)
+)
+
+type startJobRunAction struct {
+	framework.ActionWithModel[startJobRunActionModel]
+}
+
+type startJobRunActionModel struct {
+	framework.WithRegionModel
+	Arguments             fwtypes.MapOfString                         `tfsdk:"arguments"`
+	ExecutionClass        fwtypes.StringEnum[awstypes.ExecutionClass] `tfsdk:"execution_class"`
+	JobName               types.String                                `tfsdk:"job_name"`
+	JobRunTimeout         types.Int64                                 `tfsdk:"job_run_timeout" autoflex:"-"`
+	NumberOfWorkers       types.Int64                                 `tfsdk:"number_of_workers"`
+	SecurityConfiguration types.String                                `tfsdk:"security_configuration"`
+	Timeout               types.Int64                                 `tfsdk:"timeout" autoflex:"-"`
+	WorkerType            fwtypes.StringEnum[awstypes.WorkerType]     `tfsdk:"worker_type"`
+}
+
+func (a *startJobRunAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts a run of a Glue job and waits for it to complete.",
+		Attributes: map[string]schema.Attribute{
+			"arguments": schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				Description: "Job arguments for this run, replacing the job's default arguments with the same keys",
+				Optional:    true,
+			},
+			"execution_class": schema.StringAttribute{
+				CustomType:  fwtypes.StringEnumType[awstypes.ExecutionClass](),
+				Description: "Whether the job run uses standard or flexible execution",
+				Optional:    true,
+			},
+			"job_name": schema.StringAttribute{
+				Description: "Name of the job to run",
+				Required:    true,
+			},
+			"job_run_timeout": schema.Int64Attribute{
+				Description: "Timeout in minutes for the job run, overriding the job's timeout",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"number_of_workers": schema.Int64Attribute{
+				Description: "Number of workers allocated for this run, overriding the job's number of workers",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"security_configuration": schema.StringAttribute{
+				Description: "Name of the security configuration used for this run",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the job run to complete (default: 3600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(172800),
+				},
+			},
+			"worker_type": schema.StringAttribute{
+				CustomType:  fwtypes.StringEnumType[awstypes.WorkerType](),
+				Description: "Type of worker allocated for this run, overriding the job's worker type",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (a *startJobRunAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config startJobRunActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().GlueClient(ctx)
+
+	jobName := config.JobName.ValueString()
+
+	timeout := startJobRunDefaultTimeout
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting Glue job run", map[string]any{
+		"job_name":        jobName,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Starting run of Glue job %s...", jobName),
+	})
+
+	var input glue.StartJobRunInput
+	resp.Diagnostics.Append(fwflex.Expand(ctx, config, &input)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !config.JobRunTimeout.IsNull() {
+		input.Timeout = aws.Int32(int32(config.JobRunTimeout.ValueInt64()))
+	}
+
+	output, err := conn.StartJobRun(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("starting Glue Job (%s) run", jobName), err.Error())
+		return
+	}
+
+	runID := aws.ToString(output.JobRunId)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Job run %s started, waiting for completion...", runID),
+	})
+
+	// Job run state is tracked between polls so that each transition is reported exactly once.
+	var lastState awstypes.JobRunState
+	fr, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.JobRun], error) {
+		output, err := findJobRunByTwoPartKey(ctx, conn, jobName, runID)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.JobRun]{}, err
+		}
+
+		if state := output.JobRunState; state != lastState {
+			lastState = state
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Job run %s is %s", runID, state),
+			})
+		}
+
+		return actionwait.FetchResult[*awstypes.JobRun]{Status: actionwait.Status(output.JobRunState), Value: output}, nil
+	}, actionwait.Options[*awstypes.JobRun]{
+		Timeout:  timeout,
+		Interval: actionwait.FixedInterval(startJobRunPollInterval),
+		SuccessStates: []actionwait.Status{
+			actionwait.Status(awstypes.JobRunStateSucceeded),
+		},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.JobRunStateWaiting),
+			actionwait.Status(awstypes.JobRunStateStarting),
+			actionwait.Status(awstypes.JobRunStateRunning),
+			actionwait.Status(awstypes.JobRunStateStopping),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.JobRunStateFailed),
+			actionwait.Status(awstypes.JobRunStateTimeout),
+			actionwait.Status(awstypes.JobRunStateError),
+			actionwait.Status(awstypes.JobRunStateStopped),
+			actionwait.Status(awstypes.JobRunStateExpired),
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Job Run",
+				fmt.Sprintf("Glue Job (%s) run %s did not complete within %s (last state: %s)", jobName, runID, timeout, timeoutErr.LastStatus),
+			)
+		} else if errors.As(err, &failureErr) {
+			message := aws.ToString(fr.Value.ErrorMessage)
+			if message == "" {
+				message = "no error message available"
+			}
+			resp.Diagnostics.AddError(
+				"Job Run Failed",
+				fmt.Sprintf("Glue Job (%s) run %s %s: %s", jobName, runID, failureErr.Status, message),
+			)
+		} else {
+			resp.Diagnostics.AddError(fmt.Sprintf("waiting for Glue Job (%s) run (%s)", jobName, runID), err.Error())
+		}
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Job run %s succeeded after %d second(s)", runID, fr.Value.ExecutionTime),
+	})
+
+	tflog.Info(ctx, "Glue job run succeeded", map[string]any{
+		"job_name":       jobName,
+		"job_run_id":     runID,
+		"execution_time": fr.Value.ExecutionTime,
+	})
+}
+
+func findJobRunByTwoPartKey(ctx context.Context, conn *glue.Client, jobName, runID string) (*awstypes.JobRun, error) {
+	input := &glue.GetJobRunInput{
+		JobName: aws.String(jobName),
+		RunId:   aws.String(runID),
+	}
+
+	output, err := conn.GetJobRun(ctx, input)
+	if errs.IsA[*awstypes.EntityNotFoundException](err) {
+		return nil, &sdkretry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.JobRun == nil {
+		return nil, tfresource.NewEmptyResultError()
+	}
+
+	return output.JobRun, nil
+}
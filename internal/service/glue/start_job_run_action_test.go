@@ -0,0 +1,226 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package glue_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/glue/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccGlueStartJobRunAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlueServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartJobRunActionConfig_basic(rName, "print('hello')"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobRunState(ctx, t, "aws_glue_job.test", awstypes.JobRunStateSucceeded),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGlueStartJobRunAction_arguments(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlueServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				// The script fails unless the argument is passed to the job run.
+				Config: testAccStartJobRunActionConfig_arguments(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobRunState(ctx, t, "aws_glue_job.test", awstypes.JobRunStateSucceeded),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGlueStartJobRunAction_failed(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlueServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStartJobRunActionConfig_basic(rName, "raise Exception('action test failure')"),
+				ExpectError: regexache.MustCompile(`(?s)Job Run Failed.*FAILED.*action test failure`),
+			},
+		},
+	})
+}
+
+// testAccCheckJobRunState verifies that the most recent run of the Glue job has the expected state.
+func testAccCheckJobRunState(ctx context.Context, t *testing.T, n string, want awstypes.JobRunState) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).GlueClient(ctx)
+
+		input := glue.GetJobRunsInput{
+			JobName:    aws.String(rs.Primary.ID),
+			MaxResults: aws.Int32(1),
+		}
+		output, err := conn.GetJobRuns(ctx, &input)
+
+		if err != nil {
+			return err
+		}
+
+		if len(output.JobRuns) == 0 {
+			return fmt.Errorf("no job runs found for %s", rs.Primary.ID)
+		}
+
+		if got := output.JobRuns[0].JobRunState; got != want {
+			return fmt.Errorf("job run state = %s, want %s", got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccStartJobRunActionConfig_base(rName, script string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_iam_policy_document" "assume" {
+  statement {
+    actions = ["sts:AssumeRole"]
+
+    principals {
+      type        = "Service"
+      identifiers = ["glue.${data.aws_partition.current.dns_suffix}"]
+    }
+  }
+}
+
+resource "aws_iam_role" "test" {
+  name               = %[1]q
+  assume_role_policy = data.aws_iam_policy_document.assume.json
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AWSGlueServiceRole"
+  role       = aws_iam_role.test.name
+}
+
+# AWSGlueServiceRole grants access to objects in buckets whose names start with "aws-glue-".
+resource "aws_s3_bucket" "test" {
+  bucket        = "aws-glue-%[1]s"
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "script.py"
+  content = %[2]q
+}
+
+resource "aws_glue_job" "test" {
+  name         = %[1]q
+  role_arn     = aws_iam_role.test.arn
+  max_capacity = 0.0625
+
+  command {
+    name            = "pythonshell"
+    python_version  = "3.9"
+    script_location = "s3://${aws_s3_bucket.test.bucket}/${aws_s3_object.test.key}"
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, script)
+}
+
+func testAccStartJobRunActionConfig_basic(rName, script string) string {
+	return acctest.ConfigCompose(testAccStartJobRunActionConfig_base(rName, script), `
+action "aws_glue_start_job_run" "test" {
+  config {
+    job_name = aws_glue_job.test.name
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_glue_job.test.name
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_glue_start_job_run.test]
+    }
+  }
+}
+`)
+}
+
+func testAccStartJobRunActionConfig_arguments(rName string) string {
+	script := `
+import sys
+from awsglue.utils import getResolvedOptions
+
+args = getResolvedOptions(sys.argv, ["expected"])
+if args["expected"] != "value":
+    raise Exception("unexpected argument value: " + args["expected"])
+`
+	return acctest.ConfigCompose(testAccStartJobRunActionConfig_base(rName, script), `
+action "aws_glue_start_job_run" "test" {
+  config {
+    job_name        = aws_glue_job.test.name
+    job_run_timeout = 10
+    timeout         = 1800
+
+    arguments = {
+      "--expected" = "value"
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_glue_job.test.name
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_glue_start_job_run.test]
+    }
+  }
+}
+`)
+}
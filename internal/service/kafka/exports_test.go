@@ -23,6 +23,7 @@ var (
 	FindServerlessClusterByARN                   = findServerlessClusterByARN
 	FindVPCConnectionByARN                       = findVPCConnectionByARN
 
+	BuildIAMAuthToken     = buildIAMAuthToken
 	ClusterUUIDFromARN    = clusterUUIDFromARN
 	NormalizeKafkaVersion = normalizeKafkaVersion // nosemgrep:ci.kafka-in-var-name
 	SortEndpointsString   = sortEndpointsString
@@ -0,0 +1,135 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package kafka
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+)
+
+const (
+	// IAM authentication tokens are valid for 15 minutes.
+	iamAuthTokenTTL = 15 * time.Minute
+
+	// SHA-256 hash of an empty request payload.
+	iamAuthTokenEmptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+
+	iamAuthTokenUserAgent = "terraform-provider-aws"
+)
+
+// @EphemeralResource(aws_msk_iam_auth_token, name="IAM Auth Token")
+func newIAMAuthTokenEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &iamAuthTokenEphemeralResource{}, nil
+}
+
+type iamAuthTokenEphemeralResource struct {
+	framework.EphemeralResourceWithModel[iamAuthTokenEphemeralResourceModel]
+}
+
+func (e *iamAuthTokenEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"expiration": schema.StringAttribute{
+				Computed: true,
+			},
+			"expiration_ms": schema.Int64Attribute{
+				Computed: true,
+			},
+			"token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (e *iamAuthTokenEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	data := iamAuthTokenEphemeralResourceModel{}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	region := e.Meta().Region(ctx)
+	signingTime := time.Now()
+	token, err := buildIAMAuthToken(ctx, e.Meta().CredentialsProvider(ctx), region, e.Meta().DNSSuffix(ctx), signingTime)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, region)
+		return
+	}
+
+	expiration := signingTime.Add(iamAuthTokenTTL)
+	data.Expiration = types.StringValue(expiration.UTC().Format(time.RFC3339))
+	data.ExpirationMs = types.Int64Value(expiration.UnixMilli())
+	data.Token = types.StringValue(token)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.Result.Set(ctx, &data))
+}
+
+type iamAuthTokenEphemeralResourceModel struct {
+	framework.WithRegionModel
+	Expiration   types.String `tfsdk:"expiration"`
+	ExpirationMs types.Int64  `tfsdk:"expiration_ms"`
+	Token        types.String `tfsdk:"token"`
+}
+
+// buildIAMAuthToken returns a base64url-encoded SigV4 presigned kafka-cluster:Connect request,
+// compatible with the tokens generated by the MSK IAM SASL signer libraries.
+// See https://github.com/aws/aws-msk-iam-sasl-signer-go.
+func buildIAMAuthToken(ctx context.Context, credentialsProvider aws.CredentialsProvider, region, dnsSuffix string, signingTime time.Time) (string, error) {
+	if credentialsProvider == nil {
+		return "", fmt.Errorf("no AWS credentials configured")
+	}
+
+	credentials, err := credentialsProvider.Retrieve(ctx)
+	if err != nil {
+		return "", fmt.Errorf("retrieving AWS credentials: %w", err)
+	}
+
+	query := url.Values{}
+	query.Set("Action", "kafka-cluster:Connect")
+	query.Set("X-Amz-Expires", strconv.FormatInt(int64(iamAuthTokenTTL/time.Second), 10))
+
+	endpoint := url.URL{
+		Scheme:   "https",
+		Host:     fmt.Sprintf("kafka.%s.%s", region, dnsSuffix),
+		Path:     "/",
+		RawQuery: query.Encode(),
+	}
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint.String(), nil)
+	if err != nil {
+		return "", err
+	}
+
+	signedURI, _, err := v4.NewSigner().PresignHTTP(ctx, credentials, httpRequest, iamAuthTokenEmptyPayloadHash, "kafka-cluster", region, signingTime)
+	if err != nil {
+		return "", fmt.Errorf("signing IAM auth token: %w", err)
+	}
+
+	signedURL, err := url.Parse(signedURI)
+	if err != nil {
+		return "", err
+	}
+
+	// The user agent is not signed.
+	query = signedURL.Query()
+	query.Set("User-Agent", iamAuthTokenUserAgent)
+	signedURL.RawQuery = query.Encode()
+
+	return base64.RawURLEncoding.EncodeToString([]byte(signedURL.String())), nil
+}
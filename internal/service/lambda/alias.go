@@ -12,6 +12,7 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -21,12 +22,18 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2/importer"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // @SDKResource("aws_lambda_alias", name="Alias")
+// @ArnIdentity
+// @CustomImport
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/lambda;lambda.GetAliasOutput")
+// @Testing(preIdentityVersion="v6.30.0")
+// @Testing(existsTakesT=false, destroyTakesT=false)
 func resourceAlias() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceAliasCreate,
@@ -181,16 +188,38 @@ func resourceAliasDelete(ctx context.Context, d *schema.ResourceData, meta any)
 }
 
 func resourceAliasImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
-	idParts := strings.Split(d.Id(), "/")
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		return nil, fmt.Errorf("Unexpected format of ID (%q), expected FUNCTION_NAME/ALIAS", d.Id())
+	// Aliases can also be imported using "FUNCTION_NAME/ALIAS".
+	if id := d.Id(); id != "" && !arn.IsARN(id) {
+		idParts := strings.Split(id, "/")
+		if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+			return nil, fmt.Errorf("Unexpected format of ID (%q), expected FUNCTION_NAME/ALIAS", id)
+		}
+
+		functionName := idParts[0]
+		alias := idParts[1]
+
+		d.Set("function_name", functionName)
+		d.Set(names.AttrName, alias)
+		return []*schema.ResourceData{d}, nil
+	}
+
+	if err := importer.Import(ctx, d, meta); err != nil {
+		return nil, err
 	}
 
-	functionName := idParts[0]
-	alias := idParts[1]
+	// arn:${Partition}:lambda:${Region}:${Account}:function:${FunctionName}:${Alias}
+	aliasARN, err := arn.Parse(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	resourceParts := strings.Split(aliasARN.Resource, ":")
+	if len(resourceParts) != 3 || resourceParts[0] != "function" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected Lambda Alias ARN", d.Id())
+	}
 
-	d.Set("function_name", functionName)
-	d.Set(names.AttrName, alias)
+	d.Set("function_name", resourceParts[1])
+	d.Set(names.AttrName, resourceParts[2])
 	return []*schema.ResourceData{d}, nil
 }
 
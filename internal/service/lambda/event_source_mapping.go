@@ -53,7 +53,13 @@ var kafkaOrARNPattern = `$|kafka://([^.]([a-zA-Z0-9\-_.]{0,248}))|arn:(aws[a-zA-
 
 // @SDKResource("aws_lambda_event_source_mapping", name="Event Source Mapping")
 // @Tags(identifierAttribute="arn")
+// @IdentityAttribute("uuid")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/lambda;lambda.GetEventSourceMappingOutput")
+// @Testing(importIgnore="last_modified")
+// @Testing(idAttrDuplicates="uuid")
+// @Testing(preIdentityVersion="v6.30.0")
 // @Testing(tagsTest=false)
+// @Testing(existsTakesT=false, destroyTakesT=false)
 func resourceEventSourceMapping() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceEventSourceMappingCreate,
@@ -61,10 +67,6 @@ func resourceEventSourceMapping() *schema.Resource {
 		UpdateWithoutTimeout: resourceEventSourceMappingUpdate,
 		DeleteWithoutTimeout: resourceEventSourceMappingDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		SchemaFunc: func() map[string]*schema.Schema {
 			return map[string]*schema.Schema{
 				"amazon_managed_kafka_event_source_config": {
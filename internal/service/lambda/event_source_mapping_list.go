@@ -0,0 +1,138 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_lambda_event_source_mapping")
+func newEventSourceMappingResourceAsListResource() inttypes.ListResourceForSDK {
+	l := listResourceEventSourceMapping{}
+	l.SetResourceSchema(resourceEventSourceMapping())
+	return &l
+}
+
+var _ list.ListResource = &listResourceEventSourceMapping{}
+
+type listResourceEventSourceMapping struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type listEventSourceMappingModel struct {
+	framework.WithRegionModel
+	EventSourceARN types.String `tfsdk:"event_source_arn"`
+	FunctionName   types.String `tfsdk:"function_name"`
+}
+
+func (l *listResourceEventSourceMapping) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"event_source_arn": listschema.StringAttribute{
+				Optional:    true,
+				Description: "ARN of the event source to list event source mappings for.",
+			},
+			"function_name": listschema.StringAttribute{
+				Optional:    true,
+				Description: "Name or ARN of the Lambda function to list event source mappings for.",
+			},
+		},
+	}
+}
+
+func (l *listResourceEventSourceMapping) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var query listEventSourceMappingModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	awsClient := l.Meta()
+	conn := awsClient.LambdaClient(ctx)
+
+	tflog.Info(ctx, "Listing Lambda Event Source Mappings")
+	stream.Results = func(yield func(list.ListResult) bool) {
+		input := lambda.ListEventSourceMappingsInput{
+			EventSourceArn: query.EventSourceARN.ValueStringPointer(),
+			FunctionName:   query.FunctionName.ValueStringPointer(),
+		}
+		for item, err := range listEventSourceMappings(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			uuid := aws.ToString(item.UUID)
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), uuid)
+
+			result := request.NewListResult(ctx)
+			rd := l.ResourceData()
+			rd.SetId(uuid)
+
+			tflog.Info(ctx, "Reading Lambda Event Source Mapping")
+			diags := resourceEventSourceMappingRead(ctx, rd, awsClient)
+			if diags.HasError() {
+				tflog.Error(ctx, "Reading Lambda Event Source Mapping", map[string]any{
+					names.AttrID: uuid,
+					"diags":      sdkdiag.DiagnosticsString(diags),
+				})
+				continue
+			}
+			if rd.Id() == "" {
+				// Resource is logically deleted
+				continue
+			}
+
+			result.DisplayName = uuid
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+func listEventSourceMappings(ctx context.Context, conn *lambda.Client, input *lambda.ListEventSourceMappingsInput) iter.Seq2[awstypes.EventSourceMappingConfiguration, error] {
+	return func(yield func(awstypes.EventSourceMappingConfiguration, error) bool) {
+		pages := lambda.NewListEventSourceMappingsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.EventSourceMappingConfiguration{}, fmt.Errorf("listing Lambda Event Source Mappings: %w", err))
+				return
+			}
+
+			for _, item := range page.EventSourceMappings {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
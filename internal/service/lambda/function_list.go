@@ -0,0 +1,170 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_lambda_function")
+func newFunctionResourceAsListResource() inttypes.ListResourceForSDK {
+	l := listResourceFunction{}
+	l.SetResourceSchema(resourceFunction())
+	return &l
+}
+
+var _ list.ListResource = &listResourceFunction{}
+
+type listResourceFunction struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type listFunctionModel struct {
+	framework.WithRegionModel
+	NamePrefix types.String                         `tfsdk:"name_prefix"`
+	Runtime    fwtypes.StringEnum[awstypes.Runtime] `tfsdk:"runtime"`
+	Tags       tftags.Map                           `tfsdk:"tags"`
+}
+
+func (l *listResourceFunction) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			names.AttrNamePrefix: listschema.StringAttribute{
+				Optional:    true,
+				Description: "Limits the results to functions whose name begins with the specified prefix.",
+			},
+			"runtime": listschema.StringAttribute{
+				CustomType:  fwtypes.StringEnumType[awstypes.Runtime](),
+				Optional:    true,
+				Description: "Limits the results to functions using the specified runtime.",
+			},
+			names.AttrTags: listschema.MapAttribute{
+				CustomType:  tftags.MapType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Map of tags, each pair of which must exactly match a pair on the desired functions.",
+			},
+		},
+	}
+}
+
+func (l *listResourceFunction) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var query listFunctionModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	awsClient := l.Meta()
+	conn := awsClient.LambdaClient(ctx)
+
+	namePrefix := query.NamePrefix.ValueString()
+	runtime := query.Runtime.ValueEnum()
+	filterTags := tftags.New(ctx, query.Tags)
+
+	tflog.Info(ctx, "Listing Lambda Functions")
+	stream.Results = func(yield func(list.ListResult) bool) {
+		var input lambda.ListFunctionsInput
+		for item, err := range listFunctions(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			functionName := aws.ToString(item.FunctionName)
+			if !strings.HasPrefix(functionName, namePrefix) {
+				continue
+			}
+			if runtime != "" && item.Runtime != runtime {
+				continue
+			}
+
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey("function_name"), functionName)
+
+			if len(filterTags) > 0 {
+				tags, err := listTags(ctx, conn, aws.ToString(item.FunctionArn))
+				if err != nil {
+					result := fwdiag.NewListResultErrorDiagnostic(fmt.Errorf("listing tags for Lambda Function (%s): %w", functionName, err))
+					yield(result)
+					return
+				}
+				if !tags.ContainsAll(filterTags) {
+					continue
+				}
+			}
+
+			result := request.NewListResult(ctx)
+			rd := l.ResourceData()
+			rd.SetId(functionName)
+			rd.Set("function_name", functionName)
+
+			tflog.Info(ctx, "Reading Lambda Function")
+			diags := resourceFunctionRead(ctx, rd, awsClient)
+			if diags.HasError() {
+				tflog.Error(ctx, "Reading Lambda Function", map[string]any{
+					"function_name": functionName,
+					"diags":         sdkdiag.DiagnosticsString(diags),
+				})
+				continue
+			}
+			if rd.Id() == "" {
+				// Resource is logically deleted
+				continue
+			}
+
+			result.DisplayName = functionName
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+func listFunctions(ctx context.Context, conn *lambda.Client, input *lambda.ListFunctionsInput) iter.Seq2[awstypes.FunctionConfiguration, error] {
+	return func(yield func(awstypes.FunctionConfiguration, error) bool) {
+		pages := lambda.NewListFunctionsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.FunctionConfiguration{}, fmt.Errorf("listing Lambda Functions: %w", err))
+				return
+			}
+
+			for _, item := range page.Functions {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
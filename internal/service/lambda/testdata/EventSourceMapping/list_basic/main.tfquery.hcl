@@ -0,0 +1,10 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+list "aws_lambda_event_source_mapping" "test" {
+  provider = aws
+
+  config {
+    function_name = aws_lambda_function.test.function_name
+  }
+}
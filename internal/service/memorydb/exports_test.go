@@ -13,6 +13,7 @@ var (
 	ResourceSubnetGroup        = resourceSubnetGroup
 	ResourceUser               = resourceUser
 
+	BuildIAMAuthToken            = buildIAMAuthToken
 	FindACLByName                = findACLByName
 	FindClusterByName            = findClusterByName
 	FindMultiRegionClusterByName = findMultiRegionClusterByName
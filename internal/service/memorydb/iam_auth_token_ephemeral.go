@@ -0,0 +1,126 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package memorydb
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// IAM authentication tokens are valid for 15 minutes.
+	iamAuthTokenTTL = 15 * time.Minute
+
+	// SHA-256 hash of an empty request payload.
+	iamAuthTokenEmptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+)
+
+// @EphemeralResource(aws_memorydb_iam_auth_token, name="IAM Auth Token")
+func newIAMAuthTokenEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &iamAuthTokenEphemeralResource{}, nil
+}
+
+type iamAuthTokenEphemeralResource struct {
+	framework.EphemeralResourceWithModel[iamAuthTokenEphemeralResourceModel]
+}
+
+func (e *iamAuthTokenEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrClusterName: schema.StringAttribute{
+				Required: true,
+			},
+			"expiration": schema.StringAttribute{
+				Computed: true,
+			},
+			"token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			names.AttrUserName: schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (e *iamAuthTokenEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	data := iamAuthTokenEphemeralResourceModel{}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	clusterName := data.ClusterName.ValueString()
+	signingTime := time.Now()
+	token, err := buildIAMAuthToken(ctx, e.Meta().CredentialsProvider(ctx), e.Meta().Region(ctx), clusterName, data.UserName.ValueString(), signingTime)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, clusterName)
+		return
+	}
+
+	data.Expiration = types.StringValue(signingTime.Add(iamAuthTokenTTL).UTC().Format(time.RFC3339))
+	data.Token = types.StringValue(token)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.Result.Set(ctx, &data))
+}
+
+type iamAuthTokenEphemeralResourceModel struct {
+	framework.WithRegionModel
+	ClusterName types.String `tfsdk:"cluster_name"`
+	Expiration  types.String `tfsdk:"expiration"`
+	Token       types.String `tfsdk:"token"`
+	UserName    types.String `tfsdk:"user_name"`
+}
+
+// buildIAMAuthToken returns a SigV4 presigned connect request for the specified cluster and user.
+// See https://docs.aws.amazon.com/memorydb/latest/devguide/auth-iam.html.
+func buildIAMAuthToken(ctx context.Context, credentialsProvider aws.CredentialsProvider, region, clusterName, userName string, signingTime time.Time) (string, error) {
+	if credentialsProvider == nil {
+		return "", fmt.Errorf("no AWS credentials configured")
+	}
+
+	credentials, err := credentialsProvider.Retrieve(ctx)
+	if err != nil {
+		return "", fmt.Errorf("retrieving AWS credentials: %w", err)
+	}
+
+	query := url.Values{}
+	query.Set("Action", "connect")
+	query.Set("User", userName)
+	query.Set("X-Amz-Expires", strconv.FormatInt(int64(iamAuthTokenTTL/time.Second), 10))
+
+	endpoint := url.URL{
+		Scheme:   "http",
+		Host:     clusterName,
+		Path:     "/",
+		RawQuery: query.Encode(),
+	}
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint.String(), nil)
+	if err != nil {
+		return "", err
+	}
+
+	signedURI, _, err := v4.NewSigner().PresignHTTP(ctx, credentials, httpRequest, iamAuthTokenEmptyPayloadHash, "memorydb", region, signingTime)
+	if err != nil {
+		return "", fmt.Errorf("signing IAM auth token: %w", err)
+	}
+
+	return strings.TrimPrefix(signedURI, endpoint.Scheme+"://"), nil
+}
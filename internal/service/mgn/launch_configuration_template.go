@@ -0,0 +1,606 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mgn
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mgn"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mgn/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_mgn_launch_configuration_template", name="Launch Configuration Template")
+// @Tags(identifierAttribute="arn")
+// @IdentityAttribute("id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/mgn/types;awstypes;awstypes.LaunchConfigurationTemplate")
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(serialize=true)
+// @Testing(hasNoPreExistingResource=true)
+func newLaunchConfigurationTemplateResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &launchConfigurationTemplateResource{}, nil
+}
+
+type launchConfigurationTemplateResource struct {
+	framework.ResourceWithModel[launchConfigurationTemplateResourceModel]
+	framework.WithImportByIdentity
+}
+
+func (r *launchConfigurationTemplateResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	volumeConfAttribute := func() schema.ListNestedAttribute {
+		return schema.ListNestedAttribute{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[launchTemplateDiskConfModel](ctx),
+			Optional:   true,
+			Computed:   true,
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.UseStateForUnknown(),
+			},
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					names.AttrIOPS: schema.Int64Attribute{
+						Optional: true,
+					},
+					names.AttrThroughput: schema.Int64Attribute{
+						Optional: true,
+					},
+					names.AttrVolumeType: schema.StringAttribute{
+						CustomType: fwtypes.StringEnumType[awstypes.VolumeType](),
+						Optional:   true,
+					},
+				},
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"associate_public_ip_address": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"boot_mode":       launchConfigurationBootModeAttribute(),
+			"copy_private_ip": launchConfigurationBoolAttribute(),
+			"copy_tags":       launchConfigurationBoolAttribute(),
+			"ec2_launch_template_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enable_map_auto_tagging": launchConfigurationBoolAttribute(),
+			"enable_parameters_encryption": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID:         framework.IDAttribute(),
+			"large_volume_conf":  volumeConfAttribute(),
+			"launch_disposition": launchConfigurationLaunchDispositionAttribute(),
+			"licensing":          launchConfigurationLicensingAttribute(ctx),
+			"map_auto_tagging_mpe_id": schema.StringAttribute{
+				Optional: true,
+			},
+			"parameters_encryption_key": schema.StringAttribute{
+				Optional: true,
+			},
+			"post_launch_actions": launchConfigurationPostLaunchActionsAttribute(ctx),
+			"small_volume_conf":   volumeConfAttribute(),
+			"small_volume_max_size": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"target_instance_type_right_sizing_method": launchConfigurationRightSizingMethodAttribute(),
+		},
+	}
+}
+
+func (r *launchConfigurationTemplateResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan launchConfigurationTemplateResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &plan))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	var input mgn.CreateLaunchConfigurationTemplateInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, plan, &input))
+	if response.Diagnostics.HasError() {
+		return
+	}
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateLaunchConfigurationTemplate(ctx, &input)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &plan, fwflex.WithFieldNamePrefix("LaunchConfigurationTemplate")))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, plan))
+}
+
+func (r *launchConfigurationTemplateResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state launchConfigurationTemplateResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &state))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, state.ID)
+	output, err := findLaunchConfigurationTemplateByID(ctx, conn, id)
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &state, fwflex.WithFieldNamePrefix("LaunchConfigurationTemplate")))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &state))
+}
+
+func (r *launchConfigurationTemplateResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan, state launchConfigurationTemplateResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &plan))
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &state))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, plan.ID)
+
+	diff, d := fwflex.Diff(ctx, plan, state)
+	smerr.AddEnrich(ctx, &response.Diagnostics, d)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		var input mgn.UpdateLaunchConfigurationTemplateInput
+		smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, plan, &input, fwflex.WithFieldNamePrefix("LaunchConfigurationTemplate")))
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		output, err := conn.UpdateLaunchConfigurationTemplate(ctx, &input)
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+			return
+		}
+
+		smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &plan, fwflex.WithFieldNamePrefix("LaunchConfigurationTemplate")))
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &plan))
+}
+
+func (r *launchConfigurationTemplateResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var state launchConfigurationTemplateResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &state))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	id := fwflex.StringValueFromFramework(ctx, state.ID)
+	input := mgn.DeleteLaunchConfigurationTemplateInput{
+		LaunchConfigurationTemplateID: aws.String(id),
+	}
+	_, err := conn.DeleteLaunchConfigurationTemplate(ctx, &input)
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+}
+
+func findLaunchConfigurationTemplateByID(ctx context.Context, conn *mgn.Client, id string) (*awstypes.LaunchConfigurationTemplate, error) {
+	input := mgn.DescribeLaunchConfigurationTemplatesInput{
+		LaunchConfigurationTemplateIDs: []string{id},
+	}
+
+	return findLaunchConfigurationTemplate(ctx, conn, &input)
+}
+
+func findLaunchConfigurationTemplate(ctx context.Context, conn *mgn.Client, input *mgn.DescribeLaunchConfigurationTemplatesInput) (*awstypes.LaunchConfigurationTemplate, error) {
+	output, err := findLaunchConfigurationTemplates(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return smarterr.Assert(tfresource.AssertSingleValueResult(output))
+}
+
+func findLaunchConfigurationTemplates(ctx context.Context, conn *mgn.Client, input *mgn.DescribeLaunchConfigurationTemplatesInput) ([]awstypes.LaunchConfigurationTemplate, error) {
+	var output []awstypes.LaunchConfigurationTemplate
+
+	pages := mgn.NewDescribeLaunchConfigurationTemplatesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, smarterr.NewError(&retry.NotFoundError{
+				LastError: err,
+			})
+		}
+
+		if err != nil {
+			return nil, smarterr.NewError(err)
+		}
+
+		output = append(output, page.Items...)
+	}
+
+	return output, nil
+}
+
+// The following schema helpers are shared by the launch configuration template
+// and the per-source server launch configuration.
+
+func launchConfigurationBoolAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional: true,
+		Computed: true,
+		PlanModifiers: []planmodifier.Bool{
+			boolplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func launchConfigurationBootModeAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		CustomType: fwtypes.StringEnumType[awstypes.BootMode](),
+		Optional:   true,
+		Computed:   true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func launchConfigurationLaunchDispositionAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		CustomType: fwtypes.StringEnumType[awstypes.LaunchDisposition](),
+		Optional:   true,
+		Computed:   true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func launchConfigurationRightSizingMethodAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		CustomType: fwtypes.StringEnumType[awstypes.TargetInstanceTypeRightSizingMethod](),
+		Optional:   true,
+		Computed:   true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func launchConfigurationLicensingAttribute(ctx context.Context) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[licensingModel](ctx),
+		Optional:   true,
+		Computed:   true,
+		PlanModifiers: []planmodifier.List{
+			listplanmodifier.UseStateForUnknown(),
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"os_byol": schema.BoolAttribute{
+					Optional: true,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func launchConfigurationPostLaunchActionsAttribute(ctx context.Context) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[postLaunchActionsModel](ctx),
+		Optional:   true,
+		Computed:   true,
+		PlanModifiers: []planmodifier.List{
+			listplanmodifier.UseStateForUnknown(),
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"cloudwatch_log_group_name": schema.StringAttribute{
+					Optional: true,
+				},
+				"deployment": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.PostLaunchActionsDeploymentType](),
+					Optional:   true,
+					Computed:   true,
+				},
+				"s3_log_bucket": schema.StringAttribute{
+					Optional: true,
+				},
+				"s3_output_key_prefix": schema.StringAttribute{
+					Optional: true,
+				},
+				"ssm_document": schema.ListNestedAttribute{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[ssmDocumentModel](ctx),
+					Optional:   true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"action_name": schema.StringAttribute{
+								Required: true,
+							},
+							"external_parameters": schema.MapAttribute{
+								CustomType:  fwtypes.MapOfStringType,
+								ElementType: types.StringType,
+								Optional:    true,
+							},
+							"must_succeed_for_cutover": schema.BoolAttribute{
+								Optional: true,
+								Computed: true,
+							},
+							names.AttrParameter: schema.ListNestedAttribute{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[ssmDocumentParameterModel](ctx),
+								Optional:   true,
+								NestedObject: schema.NestedAttributeObject{
+									Attributes: map[string]schema.Attribute{
+										names.AttrName: schema.StringAttribute{
+											Required: true,
+										},
+										"parameter_name": schema.StringAttribute{
+											Required: true,
+										},
+										"parameter_type": schema.StringAttribute{
+											CustomType: fwtypes.StringEnumType[awstypes.SsmParameterStoreParameterType](),
+											Required:   true,
+										},
+									},
+								},
+							},
+							"ssm_document_name": schema.StringAttribute{
+								Required: true,
+							},
+							"timeout_seconds": schema.Int32Attribute{
+								Optional: true,
+								Computed: true,
+								Validators: []validator.Int32{
+									int32validator.AtLeast(1),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type launchConfigurationTemplateResourceModel struct {
+	framework.WithRegionModel
+	ARN                                 types.String                                                     `tfsdk:"arn"`
+	AssociatePublicIPAddress            types.Bool                                                       `tfsdk:"associate_public_ip_address"`
+	BootMode                            fwtypes.StringEnum[awstypes.BootMode]                            `tfsdk:"boot_mode"`
+	CopyPrivateIP                       types.Bool                                                       `tfsdk:"copy_private_ip"`
+	CopyTags                            types.Bool                                                       `tfsdk:"copy_tags"`
+	EC2LaunchTemplateID                 types.String                                                     `tfsdk:"ec2_launch_template_id"`
+	EnableMapAutoTagging                types.Bool                                                       `tfsdk:"enable_map_auto_tagging"`
+	EnableParametersEncryption          types.Bool                                                       `tfsdk:"enable_parameters_encryption"`
+	ID                                  types.String                                                     `tfsdk:"id"`
+	LargeVolumeConf                     fwtypes.ListNestedObjectValueOf[launchTemplateDiskConfModel]     `tfsdk:"large_volume_conf"`
+	LaunchDisposition                   fwtypes.StringEnum[awstypes.LaunchDisposition]                   `tfsdk:"launch_disposition"`
+	Licensing                           fwtypes.ListNestedObjectValueOf[licensingModel]                  `tfsdk:"licensing"`
+	MapAutoTaggingMpeID                 types.String                                                     `tfsdk:"map_auto_tagging_mpe_id"`
+	ParametersEncryptionKey             types.String                                                     `tfsdk:"parameters_encryption_key"`
+	PostLaunchActions                   fwtypes.ListNestedObjectValueOf[postLaunchActionsModel]          `tfsdk:"post_launch_actions"`
+	SmallVolumeConf                     fwtypes.ListNestedObjectValueOf[launchTemplateDiskConfModel]     `tfsdk:"small_volume_conf"`
+	SmallVolumeMaxSize                  types.Int64                                                      `tfsdk:"small_volume_max_size"`
+	Tags                                tftags.Map                                                       `tfsdk:"tags"`
+	TagsAll                             tftags.Map                                                       `tfsdk:"tags_all"`
+	TargetInstanceTypeRightSizingMethod fwtypes.StringEnum[awstypes.TargetInstanceTypeRightSizingMethod] `tfsdk:"target_instance_type_right_sizing_method"`
+}
+
+type launchTemplateDiskConfModel struct {
+	IOPS       types.Int64                             `tfsdk:"iops"`
+	Throughput types.Int64                             `tfsdk:"throughput"`
+	VolumeType fwtypes.StringEnum[awstypes.VolumeType] `tfsdk:"volume_type"`
+}
+
+type licensingModel struct {
+	OSBYOL types.Bool `tfsdk:"os_byol"`
+}
+
+type postLaunchActionsModel struct {
+	CloudWatchLogGroupName types.String                                                 `tfsdk:"cloudwatch_log_group_name"`
+	Deployment             fwtypes.StringEnum[awstypes.PostLaunchActionsDeploymentType] `tfsdk:"deployment"`
+	S3LogBucket            types.String                                                 `tfsdk:"s3_log_bucket"`
+	S3OutputKeyPrefix      types.String                                                 `tfsdk:"s3_output_key_prefix"`
+	SSMDocuments           fwtypes.ListNestedObjectValueOf[ssmDocumentModel]            `tfsdk:"ssm_document" autoflex:",omitempty"`
+}
+
+type ssmDocumentModel struct {
+	ActionName            types.String                                               `tfsdk:"action_name"`
+	ExternalParameters    fwtypes.MapOfString                                        `tfsdk:"external_parameters"`
+	MustSucceedForCutover types.Bool                                                 `tfsdk:"must_succeed_for_cutover"`
+	Parameters            fwtypes.ListNestedObjectValueOf[ssmDocumentParameterModel] `tfsdk:"parameter"`
+	SSMDocumentName       types.String                                               `tfsdk:"ssm_document_name"`
+	TimeoutSeconds        types.Int32                                                `tfsdk:"timeout_seconds"`
+}
+
+type ssmDocumentParameterModel struct {
+	Name          types.String                                                `tfsdk:"name"`
+	ParameterName types.String                                                `tfsdk:"parameter_name"`
+	ParameterType fwtypes.StringEnum[awstypes.SsmParameterStoreParameterType] `tfsdk:"parameter_type"`
+}
+
+var (
+	_ fwflex.Expander  = ssmDocumentModel{}
+	_ fwflex.Flattener = &ssmDocumentModel{}
+)
+
+// SSM document external parameters and parameters are maps of unions and lists,
+// which are represented as a map of dynamic paths and a flat list of parameters.
+func (m ssmDocumentModel) Expand(ctx context.Context) (any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	apiObject := awstypes.SsmDocument{
+		ActionName:            fwflex.StringFromFramework(ctx, m.ActionName),
+		MustSucceedForCutover: fwflex.BoolFromFramework(ctx, m.MustSucceedForCutover),
+		SsmDocumentName:       fwflex.StringFromFramework(ctx, m.SSMDocumentName),
+		TimeoutSeconds:        fwflex.Int32FromFramework(ctx, m.TimeoutSeconds),
+	}
+
+	if externalParameters := fwflex.ExpandFrameworkStringValueMap(ctx, m.ExternalParameters); len(externalParameters) > 0 {
+		apiObject.ExternalParameters = make(map[string]awstypes.SsmExternalParameter, len(externalParameters))
+		for k, v := range externalParameters {
+			apiObject.ExternalParameters[k] = &awstypes.SsmExternalParameterMemberDynamicPath{
+				Value: v,
+			}
+		}
+	}
+
+	parameters, d := m.Parameters.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if len(parameters) > 0 {
+		apiObject.Parameters = make(map[string][]awstypes.SsmParameterStoreParameter)
+		for _, v := range parameters {
+			name := v.Name.ValueString()
+			apiObject.Parameters[name] = append(apiObject.Parameters[name], awstypes.SsmParameterStoreParameter{
+				ParameterName: fwflex.StringFromFramework(ctx, v.ParameterName),
+				ParameterType: v.ParameterType.ValueEnum(),
+			})
+		}
+	}
+
+	return &apiObject, diags
+}
+
+func (m *ssmDocumentModel) Flatten(ctx context.Context, v any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var apiObject awstypes.SsmDocument
+	switch t := v.(type) {
+	case awstypes.SsmDocument:
+		apiObject = t
+	case *awstypes.SsmDocument:
+		if t == nil {
+			return diags
+		}
+		apiObject = *t
+	default:
+		return diags
+	}
+
+	m.ActionName = fwflex.StringToFramework(ctx, apiObject.ActionName)
+	m.MustSucceedForCutover = fwflex.BoolToFramework(ctx, apiObject.MustSucceedForCutover)
+	m.SSMDocumentName = fwflex.StringToFramework(ctx, apiObject.SsmDocumentName)
+	m.TimeoutSeconds = fwflex.Int32ToFramework(ctx, apiObject.TimeoutSeconds)
+
+	if len(apiObject.ExternalParameters) > 0 {
+		externalParameters := make(map[string]attr.Value, len(apiObject.ExternalParameters))
+		for k, v := range apiObject.ExternalParameters {
+			if v, ok := v.(*awstypes.SsmExternalParameterMemberDynamicPath); ok {
+				externalParameters[k] = types.StringValue(v.Value)
+			}
+		}
+		m.ExternalParameters = fwtypes.NewMapValueOfMust[types.String](ctx, externalParameters)
+	} else {
+		m.ExternalParameters = fwtypes.NewMapValueOfNull[types.String](ctx)
+	}
+
+	if len(apiObject.Parameters) > 0 {
+		var parameters []*ssmDocumentParameterModel
+		for _, name := range slices.Sorted(maps.Keys(apiObject.Parameters)) {
+			for _, v := range apiObject.Parameters[name] {
+				parameters = append(parameters, &ssmDocumentParameterModel{
+					Name:          types.StringValue(name),
+					ParameterName: fwflex.StringToFramework(ctx, v.ParameterName),
+					ParameterType: fwtypes.StringEnumValue(v.ParameterType),
+				})
+			}
+		}
+		m.Parameters = fwtypes.NewListNestedObjectValueOfSliceMust(ctx, parameters)
+	} else {
+		m.Parameters = fwtypes.NewListNestedObjectValueOfNull[ssmDocumentParameterModel](ctx)
+	}
+
+	return diags
+}
@@ -0,0 +1,54 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mgn_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/mgn"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mgn/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+// Launch and replication configuration templates are limited per region, so run serially.
+func TestAccMgn_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]map[string]func(t *testing.T){
+		"LaunchConfigurationTemplate": {
+			acctest.CtBasic:      testAccLaunchConfigurationTemplate_basic,
+			acctest.CtDisappears: testAccLaunchConfigurationTemplate_disappears,
+			"postLaunchActions":  testAccLaunchConfigurationTemplate_postLaunchActions,
+			"tags":               testAccMgnLaunchConfigurationTemplate_tagsSerial,
+			"Identity":           testAccMgnLaunchConfigurationTemplate_IdentitySerial,
+		},
+		"ReplicationConfigurationTemplate": {
+			acctest.CtBasic:      testAccReplicationConfigurationTemplate_basic,
+			acctest.CtDisappears: testAccReplicationConfigurationTemplate_disappears,
+			"update":             testAccReplicationConfigurationTemplate_update,
+			"tags":               testAccMgnReplicationConfigurationTemplate_tagsSerial,
+			"Identity":           testAccMgnReplicationConfigurationTemplate_IdentitySerial,
+		},
+	}
+
+	acctest.RunSerialTests2Levels(t, testCases, 0)
+}
+
+func testAccPreCheck(ctx context.Context, t *testing.T) {
+	conn := acctest.ProviderMeta(ctx, t).MgnClient(ctx)
+
+	input := mgn.DescribeReplicationConfigurationTemplatesInput{}
+
+	_, err := conn.DescribeReplicationConfigurationTemplates(ctx, &input)
+
+	if acctest.PreCheckSkipError(err) || errs.IsA[*awstypes.UninitializedAccountException](err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
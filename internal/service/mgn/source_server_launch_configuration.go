@@ -0,0 +1,237 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mgn
+
+import (
+	"context"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mgn"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mgn/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_mgn_source_server_launch_configuration", name="Source Server Launch Configuration")
+// @IdentityAttribute("source_server_id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/mgn;mgn.GetLaunchConfigurationOutput")
+// @Testing(preCheck="testAccPreCheck")
+// @Testing(requireEnvVarValue="MGN_SOURCE_SERVER_ID")
+// @Testing(importStateIdAttribute="source_server_id")
+// @Testing(checkDestroyNoop=true)
+// @Testing(hasNoPreExistingResource=true)
+func newSourceServerLaunchConfigurationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &sourceServerLaunchConfigurationResource{}, nil
+}
+
+type sourceServerLaunchConfigurationResource struct {
+	framework.ResourceWithModel[sourceServerLaunchConfigurationResourceModel]
+	framework.WithImportByIdentity
+}
+
+func (r *sourceServerLaunchConfigurationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"boot_mode":       launchConfigurationBootModeAttribute(),
+			"copy_private_ip": launchConfigurationBoolAttribute(),
+			"copy_tags":       launchConfigurationBoolAttribute(),
+			"ec2_launch_template_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enable_map_auto_tagging": launchConfigurationBoolAttribute(),
+			"launch_disposition":      launchConfigurationLaunchDispositionAttribute(),
+			"licensing":               launchConfigurationLicensingAttribute(ctx),
+			"map_auto_tagging_mpe_id": schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"post_launch_actions": launchConfigurationPostLaunchActionsAttribute(ctx),
+			"source_server_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_instance_type_right_sizing_method": launchConfigurationRightSizingMethodAttribute(),
+		},
+	}
+}
+
+func (r *sourceServerLaunchConfigurationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan sourceServerLaunchConfigurationResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &plan))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	// The launch configuration is created along with the source server, so "creating" the resource updates it.
+	sourceServerID := fwflex.StringValueFromFramework(ctx, plan.SourceServerID)
+	output, err := updateSourceServerLaunchConfiguration(ctx, conn, plan)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, sourceServerID)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &plan))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, plan))
+}
+
+func (r *sourceServerLaunchConfigurationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state sourceServerLaunchConfigurationResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &state))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	sourceServerID := fwflex.StringValueFromFramework(ctx, state.SourceServerID)
+	output, err := findSourceServerLaunchConfigurationByID(ctx, conn, sourceServerID)
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, sourceServerID)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &state))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &state))
+}
+
+func (r *sourceServerLaunchConfigurationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan, state sourceServerLaunchConfigurationResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &plan))
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &state))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	sourceServerID := fwflex.StringValueFromFramework(ctx, plan.SourceServerID)
+
+	diff, d := fwflex.Diff(ctx, plan, state)
+	smerr.AddEnrich(ctx, &response.Diagnostics, d)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		output, err := updateSourceServerLaunchConfiguration(ctx, conn, plan)
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, sourceServerID)
+			return
+		}
+
+		smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &plan))
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &plan))
+}
+
+func (r *sourceServerLaunchConfigurationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var state sourceServerLaunchConfigurationResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &state))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// The launch configuration exists for as long as the source server does, so there's nothing to delete.
+	tflog.Debug(ctx, "Removing MGN Source Server Launch Configuration from state", map[string]any{
+		"source_server_id": state.SourceServerID.ValueString(),
+	})
+}
+
+func updateSourceServerLaunchConfiguration(ctx context.Context, conn *mgn.Client, data sourceServerLaunchConfigurationResourceModel) (*mgn.UpdateLaunchConfigurationOutput, error) {
+	var input mgn.UpdateLaunchConfigurationInput
+	if diags := fwflex.Expand(ctx, data, &input); diags.HasError() {
+		return nil, smarterr.NewError(fwdiag.DiagnosticsError(diags))
+	}
+
+	output, err := conn.UpdateLaunchConfiguration(ctx, &input)
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	return output, nil
+}
+
+func findSourceServerLaunchConfigurationByID(ctx context.Context, conn *mgn.Client, sourceServerID string) (*mgn.GetLaunchConfigurationOutput, error) {
+	input := mgn.GetLaunchConfigurationInput{
+		SourceServerID: aws.String(sourceServerID),
+	}
+	output, err := conn.GetLaunchConfiguration(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			LastError: err,
+		})
+	}
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if output == nil {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError())
+	}
+
+	return output, nil
+}
+
+type sourceServerLaunchConfigurationResourceModel struct {
+	framework.WithRegionModel
+	BootMode                            fwtypes.StringEnum[awstypes.BootMode]                            `tfsdk:"boot_mode"`
+	CopyPrivateIP                       types.Bool                                                       `tfsdk:"copy_private_ip"`
+	CopyTags                            types.Bool                                                       `tfsdk:"copy_tags"`
+	EC2LaunchTemplateID                 types.String                                                     `tfsdk:"ec2_launch_template_id"`
+	EnableMapAutoTagging                types.Bool                                                       `tfsdk:"enable_map_auto_tagging"`
+	LaunchDisposition                   fwtypes.StringEnum[awstypes.LaunchDisposition]                   `tfsdk:"launch_disposition"`
+	Licensing                           fwtypes.ListNestedObjectValueOf[licensingModel]                  `tfsdk:"licensing"`
+	MapAutoTaggingMpeID                 types.String                                                     `tfsdk:"map_auto_tagging_mpe_id"`
+	Name                                types.String                                                     `tfsdk:"name"`
+	PostLaunchActions                   fwtypes.ListNestedObjectValueOf[postLaunchActionsModel]          `tfsdk:"post_launch_actions"`
+	SourceServerID                      types.String                                                     `tfsdk:"source_server_id"`
+	TargetInstanceTypeRightSizingMethod fwtypes.StringEnum[awstypes.TargetInstanceTypeRightSizingMethod] `tfsdk:"target_instance_type_right_sizing_method"`
+}
@@ -0,0 +1,129 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mgn
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mgn"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mgn/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+)
+
+// Function annotations are used for list resource registration to the Provider. DO NOT EDIT.
+// @FrameworkListResource("aws_mgn_source_server_launch_configuration")
+func newSourceServerLaunchConfigurationResourceAsListResource() list.ListResourceWithConfigure {
+	return &sourceServerLaunchConfigurationListResource{}
+}
+
+var _ list.ListResource = &sourceServerLaunchConfigurationListResource{}
+
+type sourceServerLaunchConfigurationListResource struct {
+	sourceServerLaunchConfigurationResource
+	framework.WithList
+}
+
+func (r *sourceServerLaunchConfigurationListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var query listSourceServerLaunchConfigurationModel
+
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	awsClient := r.Meta()
+	conn := awsClient.MgnClient(ctx)
+
+	stream.Results = func(yield func(list.ListResult) bool) {
+		result := request.NewListResult(ctx)
+		input := mgn.DescribeSourceServersInput{
+			Filters: &awstypes.DescribeSourceServersRequestFilters{
+				IsArchived: aws.Bool(false),
+			},
+		}
+		for sourceServer, err := range listSourceServers(ctx, conn, &input) {
+			if err != nil {
+				result = fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			sourceServerID := aws.ToString(sourceServer.SourceServerID)
+			launchConfiguration, err := findSourceServerLaunchConfigurationByID(ctx, conn, sourceServerID)
+			if retry.NotFound(err) {
+				continue
+			}
+			if err != nil {
+				result = fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			var data sourceServerLaunchConfigurationResourceModel
+
+			r.SetResult(ctx, awsClient, &data, &result, func() {
+				if diags := fwflex.Flatten(ctx, launchConfiguration, &data); diags.HasError() {
+					result.Diagnostics.Append(diags...)
+					yield(result)
+					return
+				}
+
+				result.DisplayName = sourceServerDisplayName(sourceServer)
+			})
+
+			if result.Diagnostics.HasError() {
+				result = list.ListResult{Diagnostics: result.Diagnostics}
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+type listSourceServerLaunchConfigurationModel struct {
+	framework.WithRegionModel
+}
+
+// sourceServerDisplayName returns the source server's hostname, falling back to its ID.
+func sourceServerDisplayName(sourceServer awstypes.SourceServer) string {
+	if v := sourceServer.SourceProperties; v != nil && v.IdentificationHints != nil {
+		if hostname := aws.ToString(v.IdentificationHints.Hostname); hostname != "" {
+			return hostname
+		}
+	}
+
+	return aws.ToString(sourceServer.SourceServerID)
+}
+
+func listSourceServers(ctx context.Context, conn *mgn.Client, input *mgn.DescribeSourceServersInput) iter.Seq2[awstypes.SourceServer, error] {
+	return func(yield func(awstypes.SourceServer, error) bool) {
+		pages := mgn.NewDescribeSourceServersPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.SourceServer{}, fmt.Errorf("listing MGN Source Servers: %w", err))
+				return
+			}
+
+			for _, sourceServer := range page.Items {
+				if !yield(sourceServer, nil) {
+					return
+				}
+			}
+		}
+	}
+}
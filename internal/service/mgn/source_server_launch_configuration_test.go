@@ -0,0 +1,102 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mgn_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/mgn"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mgn/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfknownvalue "github.com/hashicorp/terraform-provider-aws/internal/acctest/knownvalue"
+	tfmgn "github.com/hashicorp/terraform-provider-aws/internal/service/mgn"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Source servers can only be added by installing the replication agent, so these tests require an existing one.
+func TestAccMgnSourceServerLaunchConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v mgn.GetLaunchConfigurationOutput
+	sourceServerID := acctest.SkipIfEnvVarNotSet(t, "MGN_SOURCE_SERVER_ID")
+	resourceName := "aws_mgn_source_server_launch_configuration.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MgnServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceServerLaunchConfigurationConfig_basic(sourceServerID, string(awstypes.LaunchDispositionStopped)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSourceServerLaunchConfigurationExists(ctx, t, resourceName, &v),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("ec2_launch_template_id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("launch_disposition"), tfknownvalue.StringExact(awstypes.LaunchDispositionStopped)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("source_server_id"), knownvalue.StringExact(sourceServerID)),
+				},
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "source_server_id",
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, "source_server_id"),
+			},
+			{
+				Config: testAccSourceServerLaunchConfigurationConfig_basic(sourceServerID, string(awstypes.LaunchDispositionStarted)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSourceServerLaunchConfigurationExists(ctx, t, resourceName, &v),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("launch_disposition"), tfknownvalue.StringExact(awstypes.LaunchDispositionStarted)),
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckSourceServerLaunchConfigurationExists(ctx context.Context, t *testing.T, n string, v *mgn.GetLaunchConfigurationOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).MgnClient(ctx)
+
+		output, err := tfmgn.FindSourceServerLaunchConfigurationByID(ctx, conn, rs.Primary.Attributes["source_server_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccSourceServerLaunchConfigurationConfig_basic(sourceServerID, launchDisposition string) string {
+	return fmt.Sprintf(`
+resource "aws_mgn_source_server_launch_configuration" "test" {
+  source_server_id   = %[1]q
+  launch_disposition = %[2]q
+}
+`, sourceServerID, launchDisposition)
+}
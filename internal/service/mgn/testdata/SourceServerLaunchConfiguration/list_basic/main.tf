@@ -0,0 +1,13 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_mgn_source_server_launch_configuration" "test" {
+  source_server_id   = var.MGN_SOURCE_SERVER_ID
+  launch_disposition = "STOPPED"
+}
+
+variable "MGN_SOURCE_SERVER_ID" {
+  description = "ID of an existing MGN source server"
+  type        = string
+  nullable    = false
+}
@@ -0,0 +1,26 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_mgn_source_server_launch_configuration" "test" {
+  region = var.region
+
+  source_server_id   = var.MGN_SOURCE_SERVER_ID
+  launch_disposition = "STOPPED"
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
+
+variable "MGN_SOURCE_SERVER_ID" {
+  type     = string
+  nullable = false
+}
+
+variable "region" {
+  description = "Region to deploy resource in"
+  type        = string
+  nullable    = false
+}
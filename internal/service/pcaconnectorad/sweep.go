@@ -0,0 +1,143 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package pcaconnectorad
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pcaconnectorad"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func RegisterSweepers() {
+	awsv2.Register("aws_pcaconnectorad_connector", sweepConnectors, "aws_pcaconnectorad_service_principal_name", "aws_pcaconnectorad_template")
+	awsv2.Register("aws_pcaconnectorad_directory_registration", sweepDirectoryRegistrations, "aws_pcaconnectorad_service_principal_name")
+	awsv2.Register("aws_pcaconnectorad_service_principal_name", sweepServicePrincipalNames)
+	awsv2.Register("aws_pcaconnectorad_template", sweepTemplates)
+}
+
+func sweepConnectors(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.PCAConnectorADClient(ctx)
+	var input pcaconnectorad.ListConnectorsInput
+	var sweepResources []sweep.Sweepable
+
+	pages := pcaconnectorad.NewListConnectorsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Connectors {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newConnectorResource, client,
+				framework.NewAttribute(names.AttrARN, aws.ToString(v.Arn))))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepDirectoryRegistrations(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.PCAConnectorADClient(ctx)
+	var input pcaconnectorad.ListDirectoryRegistrationsInput
+	var sweepResources []sweep.Sweepable
+
+	pages := pcaconnectorad.NewListDirectoryRegistrationsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.DirectoryRegistrations {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newDirectoryRegistrationResource, client,
+				framework.NewAttribute(names.AttrARN, aws.ToString(v.Arn))))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepServicePrincipalNames(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.PCAConnectorADClient(ctx)
+	var input pcaconnectorad.ListDirectoryRegistrationsInput
+	var sweepResources []sweep.Sweepable
+
+	pages := pcaconnectorad.NewListDirectoryRegistrationsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.DirectoryRegistrations {
+			directoryRegistrationARN := aws.ToString(v.Arn)
+			input := pcaconnectorad.ListServicePrincipalNamesInput{
+				DirectoryRegistrationArn: aws.String(directoryRegistrationARN),
+			}
+
+			pages := pcaconnectorad.NewListServicePrincipalNamesPaginator(conn, &input)
+			for pages.HasMorePages() {
+				page, err := pages.NextPage(ctx)
+
+				if err != nil {
+					return nil, err
+				}
+
+				for _, v := range page.ServicePrincipalNames {
+					sweepResources = append(sweepResources, framework.NewSweepResource(newServicePrincipalNameResource, client,
+						framework.NewAttribute("connector_arn", aws.ToString(v.ConnectorArn)),
+						framework.NewAttribute("directory_registration_arn", directoryRegistrationARN)))
+				}
+			}
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepTemplates(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.PCAConnectorADClient(ctx)
+	var input pcaconnectorad.ListConnectorsInput
+	var sweepResources []sweep.Sweepable
+
+	pages := pcaconnectorad.NewListConnectorsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Connectors {
+			input := pcaconnectorad.ListTemplatesInput{
+				ConnectorArn: v.Arn,
+			}
+
+			pages := pcaconnectorad.NewListTemplatesPaginator(conn, &input)
+			for pages.HasMorePages() {
+				page, err := pages.NextPage(ctx)
+
+				if err != nil {
+					return nil, err
+				}
+
+				for _, v := range page.Templates {
+					sweepResources = append(sweepResources, framework.NewSweepResource(newTemplateResource, client,
+						framework.NewAttribute(names.AttrARN, aws.ToString(v.Arn))))
+				}
+			}
+		}
+	}
+
+	return sweepResources, nil
+}
@@ -0,0 +1,933 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package pcaconnectorad
+
+import (
+	"context"
+
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pcaconnectorad"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pcaconnectorad/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_pcaconnectorad_template", name="Template")
+// @Tags(identifierAttribute="arn")
+// @ArnIdentity
+func newTemplateResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &templateResource{}, nil
+}
+
+type templateResource struct {
+	framework.ResourceWithModel[templateResourceModel]
+	framework.WithImportByIdentity
+}
+
+func (r *templateResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"connector_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			"object_identifier": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"policy_schema": schema.Int32Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"reenroll_all_certificate_holders": schema.BoolAttribute{
+				Optional: true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"definition": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[templateDefinitionModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"template_v2": templateVersionBlock[templateV2Model](ctx,
+							templatePrivateKeyAttributesV2Block(ctx),
+							templatePrivateKeyFlagsBlock[privateKeyFlagsV2Model, awstypes.ClientCompatibilityV2](ctx),
+							nil,
+							path.MatchRelative().AtParent().AtName("template_v3"),
+							path.MatchRelative().AtParent().AtName("template_v4"),
+						),
+						"template_v3": templateVersionBlock[templateV3Model](ctx,
+							templatePrivateKeyAttributesBlock(ctx, true),
+							templatePrivateKeyFlagsBlock[privateKeyFlagsV3Model, awstypes.ClientCompatibilityV3](ctx, "require_alternate_signature_algorithm"),
+							&schema.StringAttribute{
+								CustomType: fwtypes.StringEnumType[awstypes.HashAlgorithm](),
+								Required:   true,
+							},
+							path.MatchRelative().AtParent().AtName("template_v2"),
+							path.MatchRelative().AtParent().AtName("template_v4"),
+						),
+						"template_v4": templateVersionBlock[templateV4Model](ctx,
+							templatePrivateKeyAttributesBlock(ctx, false),
+							templatePrivateKeyFlagsBlock[privateKeyFlagsV4Model, awstypes.ClientCompatibilityV4](ctx, "require_alternate_signature_algorithm", "require_same_key_renewal", "use_legacy_provider"),
+							&schema.StringAttribute{
+								CustomType: fwtypes.StringEnumType[awstypes.HashAlgorithm](),
+								Optional:   true,
+								Computed:   true,
+								PlanModifiers: []planmodifier.String{
+									stringplanmodifier.UseStateForUnknown(),
+								},
+							},
+							path.MatchRelative().AtParent().AtName("template_v2"),
+							path.MatchRelative().AtParent().AtName("template_v3"),
+						),
+					},
+				},
+			},
+		},
+	}
+}
+
+// templateVersionBlock returns the schema for one version of a template definition.
+// hashAlgorithm is nil for template versions that don't support it.
+func templateVersionBlock[T any](ctx context.Context, privateKeyAttributes, privateKeyFlags schema.Block, hashAlgorithm *schema.StringAttribute, conflicts ...path.Expression) schema.ListNestedBlock {
+	attributes := map[string]schema.Attribute{
+		"superseded_templates": schema.ListAttribute{
+			CustomType:  fwtypes.ListOfStringType,
+			ElementType: types.StringType,
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.UseStateForUnknown(),
+			},
+			Validators: []validator.List{
+				listvalidator.SizeBetween(1, 100),
+			},
+		},
+	}
+	if hashAlgorithm != nil {
+		attributes["hash_algorithm"] = *hashAlgorithm
+	}
+
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[T](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+			listvalidator.ExactlyOneOf(conflicts...),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: attributes,
+			Blocks: map[string]schema.Block{
+				"certificate_validity": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[certificateValidityModel](ctx),
+					Validators: templateRequiredSingleBlockValidators(),
+					NestedObject: schema.NestedBlockObject{
+						Blocks: map[string]schema.Block{
+							"renewal_period":  templateValidityPeriodBlock(ctx),
+							"validity_period": templateValidityPeriodBlock(ctx),
+						},
+					},
+				},
+				"enrollment_flags": templateFlagsBlock[enrollmentFlagsModel](ctx, false,
+					"enable_key_reuse_on_nt_token_keyset_storage_full",
+					"include_symmetric_algorithms",
+					"no_security_extension",
+					"remove_invalid_certificate_from_personal_store",
+					"user_interaction_required",
+				),
+				"extensions": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[extensionsModel](ctx),
+					Validators: templateRequiredSingleBlockValidators(),
+					NestedObject: schema.NestedBlockObject{
+						Blocks: map[string]schema.Block{
+							"application_policies": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[applicationPoliciesModel](ctx),
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"critical": templateBoolAttribute(),
+									},
+									Blocks: map[string]schema.Block{
+										names.AttrPolicy: schema.ListNestedBlock{
+											CustomType: fwtypes.NewListNestedObjectTypeOf[applicationPolicyModel](ctx),
+											Validators: []validator.List{
+												listvalidator.IsRequired(),
+												listvalidator.SizeBetween(1, 100),
+											},
+											NestedObject: schema.NestedBlockObject{
+												Attributes: map[string]schema.Attribute{
+													"policy_object_identifier": schema.StringAttribute{
+														Optional: true,
+														Validators: []validator.String{
+															stringvalidator.LengthBetween(1, 64),
+															stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("policy_type")),
+														},
+													},
+													"policy_type": schema.StringAttribute{
+														CustomType: fwtypes.StringEnumType[awstypes.ApplicationPolicyType](),
+														Optional:   true,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							"key_usage": schema.ListNestedBlock{
+								CustomType: fwtypes.NewListNestedObjectTypeOf[keyUsageModel](ctx),
+								Validators: templateRequiredSingleBlockValidators(),
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"critical": templateBoolAttribute(),
+									},
+									Blocks: map[string]schema.Block{
+										"usage_flags": templateFlagsBlock[keyUsageFlagsModel](ctx, false,
+											"data_encipherment",
+											"digital_signature",
+											"key_agreement",
+											"key_encipherment",
+											"non_repudiation",
+										),
+									},
+								},
+							},
+						},
+					},
+				},
+				"general_flags": templateFlagsBlock[generalFlagsModel](ctx, false,
+					"auto_enrollment",
+					"machine_type",
+				),
+				"private_key_attributes": privateKeyAttributes,
+				"private_key_flags":      privateKeyFlags,
+				"subject_name_flags": templateFlagsBlock[subjectNameFlagsModel](ctx, false,
+					"require_common_name",
+					"require_directory_path",
+					"require_dns_as_cn",
+					"require_email",
+					"san_require_directory_guid",
+					"san_require_dns",
+					"san_require_domain_dns",
+					"san_require_email",
+					"san_require_spn",
+					"san_require_upn",
+				),
+			},
+		},
+	}
+}
+
+func templateValidityPeriodBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[validityPeriodModel](ctx),
+		Validators: templateRequiredSingleBlockValidators(),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"period": schema.Int64Attribute{
+					Required: true,
+					Validators: []validator.Int64{
+						int64validator.Between(1, 8766000),
+					},
+				},
+				"period_type": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.ValidityPeriodType](),
+					Required:   true,
+				},
+			},
+		},
+	}
+}
+
+func templatePrivateKeyAttributesV2Block(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[privateKeyAttributesV2Model](ctx),
+		Validators: templateRequiredSingleBlockValidators(),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: templatePrivateKeyAttributesAttributes(),
+		},
+	}
+}
+
+// templatePrivateKeyAttributesBlock returns the schema for the V3 and V4 private key attributes.
+// The algorithm and key usage property are required for V3 templates and optional for V4 templates.
+func templatePrivateKeyAttributesBlock(ctx context.Context, required bool) schema.ListNestedBlock {
+	attributes := templatePrivateKeyAttributesAttributes()
+	algorithm := schema.StringAttribute{
+		CustomType: fwtypes.StringEnumType[awstypes.PrivateKeyAlgorithm](),
+		Required:   required,
+	}
+	if !required {
+		algorithm.Optional = true
+		algorithm.Computed = true
+		algorithm.PlanModifiers = []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		}
+	}
+	attributes["algorithm"] = algorithm
+
+	keyUsagePropertyValidators := []validator.List{
+		listvalidator.SizeAtMost(1),
+	}
+	if required {
+		keyUsagePropertyValidators = append(keyUsagePropertyValidators, listvalidator.IsRequired(), listvalidator.SizeAtLeast(1))
+	}
+
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[privateKeyAttributesModel](ctx),
+		Validators: templateRequiredSingleBlockValidators(),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: attributes,
+			Blocks: map[string]schema.Block{
+				"key_usage_property": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[keyUsagePropertyModel](ctx),
+					Validators: keyUsagePropertyValidators,
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"property_type": schema.StringAttribute{
+								CustomType: fwtypes.StringEnumType[awstypes.KeyUsagePropertyType](),
+								Optional:   true,
+								Validators: []validator.String{
+									stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("property_flags")),
+								},
+							},
+						},
+						Blocks: map[string]schema.Block{
+							"property_flags": templateFlagsBlock[keyUsagePropertyFlagsModel](ctx, true,
+								"decrypt",
+								"key_agreement",
+								"sign",
+							),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func templatePrivateKeyAttributesAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"crypto_providers": schema.ListAttribute{
+			CustomType:  fwtypes.ListOfStringType,
+			ElementType: types.StringType,
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.UseStateForUnknown(),
+			},
+			Validators: []validator.List{
+				listvalidator.SizeBetween(1, 100),
+				listvalidator.ValueStringsAre(stringvalidator.LengthBetween(1, 100)),
+			},
+		},
+		"key_spec": schema.StringAttribute{
+			CustomType: fwtypes.StringEnumType[awstypes.KeySpec](),
+			Required:   true,
+		},
+		"minimal_key_length": schema.Int32Attribute{
+			Required: true,
+			Validators: []validator.Int32{
+				int32validator.AtLeast(1),
+			},
+		},
+	}
+}
+
+func templatePrivateKeyFlagsBlock[T any, E enum.Valueser[E]](ctx context.Context, flags ...string) schema.ListNestedBlock {
+	block := templateFlagsBlock[T](ctx, false, append([]string{"exportable_key", "strong_key_protection_required"}, flags...)...)
+	block.NestedObject.Attributes["client_version"] = schema.StringAttribute{
+		CustomType: fwtypes.StringEnumType[E](),
+		Required:   true,
+	}
+
+	return block
+}
+
+// templateFlagsBlock returns the schema for a single nested block of boolean flags.
+func templateFlagsBlock[T any](ctx context.Context, optional bool, flags ...string) schema.ListNestedBlock {
+	attributes := make(map[string]schema.Attribute, len(flags))
+	for _, flag := range flags {
+		attributes[flag] = templateBoolAttribute()
+	}
+
+	validators := templateRequiredSingleBlockValidators()
+	if optional {
+		validators = []validator.List{
+			listvalidator.SizeAtMost(1),
+		}
+	}
+
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[T](ctx),
+		Validators: validators,
+		NestedObject: schema.NestedBlockObject{
+			Attributes: attributes,
+		},
+	}
+}
+
+func templateBoolAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional: true,
+		Computed: true,
+		PlanModifiers: []planmodifier.Bool{
+			boolplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func templateRequiredSingleBlockValidators() []validator.List {
+	return []validator.List{
+		listvalidator.IsRequired(),
+		listvalidator.SizeAtLeast(1),
+		listvalidator.SizeAtMost(1),
+	}
+}
+
+func (r *templateResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data templateResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCAConnectorADClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, data.Name)
+	var input pcaconnectorad.CreateTemplateInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, data, &input))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(sdkid.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateTemplate(ctx, &input)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, name)
+		return
+	}
+
+	arn := aws.ToString(output.TemplateArn)
+	template, err := findTemplateByARN(ctx, conn, arn)
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrARN), arn) // Set 'arn' so as to taint the resource.
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, template, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, data))
+}
+
+func (r *templateResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data templateResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCAConnectorADClient(ctx)
+
+	arn := fwflex.StringValueFromFramework(ctx, data.ARN)
+	output, err := findTemplateByARN(ctx, conn, arn)
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func (r *templateResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old templateResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &new))
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &old))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCAConnectorADClient(ctx)
+
+	arn := fwflex.StringValueFromFramework(ctx, new.ARN)
+
+	if !new.Definition.Equal(old.Definition) {
+		var input pcaconnectorad.UpdateTemplateInput
+		smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, new, &input, fwflex.WithFieldNamePrefix("Template")))
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateTemplate(ctx, &input)
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+			return
+		}
+
+		template, err := findTemplateByARN(ctx, conn, arn)
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+			return
+		}
+
+		smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, template, &new))
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &new))
+}
+
+func (r *templateResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data templateResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCAConnectorADClient(ctx)
+
+	arn := fwflex.StringValueFromFramework(ctx, data.ARN)
+	input := pcaconnectorad.DeleteTemplateInput{
+		TemplateArn: aws.String(arn),
+	}
+	_, err := conn.DeleteTemplate(ctx, &input)
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, arn)
+		return
+	}
+}
+
+func findTemplateByARN(ctx context.Context, conn *pcaconnectorad.Client, arn string) (*awstypes.Template, error) {
+	input := pcaconnectorad.GetTemplateInput{
+		TemplateArn: aws.String(arn),
+	}
+	output, err := conn.GetTemplate(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			LastError: err,
+		})
+	}
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if output == nil || output.Template == nil {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError())
+	}
+
+	return output.Template, nil
+}
+
+type templateResourceModel struct {
+	framework.WithRegionModel
+	ARN                           types.String                                             `tfsdk:"arn"`
+	ConnectorARN                  fwtypes.ARN                                              `tfsdk:"connector_arn"`
+	Definition                    fwtypes.ListNestedObjectValueOf[templateDefinitionModel] `tfsdk:"definition"`
+	Name                          types.String                                             `tfsdk:"name"`
+	ObjectIdentifier              types.String                                             `tfsdk:"object_identifier"`
+	PolicySchema                  types.Int32                                              `tfsdk:"policy_schema"`
+	ReenrollAllCertificateHolders types.Bool                                               `tfsdk:"reenroll_all_certificate_holders"`
+	Tags                          tftags.Map                                               `tfsdk:"tags"`
+	TagsAll                       tftags.Map                                               `tfsdk:"tags_all"`
+}
+
+type templateDefinitionModel struct {
+	TemplateV2 fwtypes.ListNestedObjectValueOf[templateV2Model] `tfsdk:"template_v2"`
+	TemplateV3 fwtypes.ListNestedObjectValueOf[templateV3Model] `tfsdk:"template_v3"`
+	TemplateV4 fwtypes.ListNestedObjectValueOf[templateV4Model] `tfsdk:"template_v4"`
+}
+
+var (
+	_ fwflex.Expander  = templateDefinitionModel{}
+	_ fwflex.Flattener = &templateDefinitionModel{}
+)
+
+func (m templateDefinitionModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	templateV2Data, d := m.TemplateV2.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	templateV3Data, d := m.TemplateV3.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	templateV4Data, d := m.TemplateV4.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	switch {
+	case templateV2Data != nil:
+		var r awstypes.TemplateDefinitionMemberTemplateV2
+		diags.Append(fwflex.Expand(ctx, templateV2Data, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case templateV3Data != nil:
+		var r awstypes.TemplateDefinitionMemberTemplateV3
+		diags.Append(fwflex.Expand(ctx, templateV3Data, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case templateV4Data != nil:
+		var r awstypes.TemplateDefinitionMemberTemplateV4
+		diags.Append(fwflex.Expand(ctx, templateV4Data, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *templateDefinitionModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.TemplateDefinitionMemberTemplateV2:
+		var model templateV2Model
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.TemplateV2 = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+
+	case awstypes.TemplateDefinitionMemberTemplateV3:
+		var model templateV3Model
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.TemplateV3 = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+
+	case awstypes.TemplateDefinitionMemberTemplateV4:
+		var model templateV4Model
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.TemplateV4 = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+	}
+
+	return diags
+}
+
+type templateV2Model struct {
+	CertificateValidity  fwtypes.ListNestedObjectValueOf[certificateValidityModel]    `tfsdk:"certificate_validity"`
+	EnrollmentFlags      fwtypes.ListNestedObjectValueOf[enrollmentFlagsModel]        `tfsdk:"enrollment_flags"`
+	Extensions           fwtypes.ListNestedObjectValueOf[extensionsModel]             `tfsdk:"extensions"`
+	GeneralFlags         fwtypes.ListNestedObjectValueOf[generalFlagsModel]           `tfsdk:"general_flags"`
+	PrivateKeyAttributes fwtypes.ListNestedObjectValueOf[privateKeyAttributesV2Model] `tfsdk:"private_key_attributes"`
+	PrivateKeyFlags      fwtypes.ListNestedObjectValueOf[privateKeyFlagsV2Model]      `tfsdk:"private_key_flags"`
+	SubjectNameFlags     fwtypes.ListNestedObjectValueOf[subjectNameFlagsModel]       `tfsdk:"subject_name_flags"`
+	SupersededTemplates  fwtypes.ListOfString                                         `tfsdk:"superseded_templates"`
+}
+
+type templateV3Model struct {
+	CertificateValidity  fwtypes.ListNestedObjectValueOf[certificateValidityModel]  `tfsdk:"certificate_validity"`
+	EnrollmentFlags      fwtypes.ListNestedObjectValueOf[enrollmentFlagsModel]      `tfsdk:"enrollment_flags"`
+	Extensions           fwtypes.ListNestedObjectValueOf[extensionsModel]           `tfsdk:"extensions"`
+	GeneralFlags         fwtypes.ListNestedObjectValueOf[generalFlagsModel]         `tfsdk:"general_flags"`
+	HashAlgorithm        fwtypes.StringEnum[awstypes.HashAlgorithm]                 `tfsdk:"hash_algorithm"`
+	PrivateKeyAttributes fwtypes.ListNestedObjectValueOf[privateKeyAttributesModel] `tfsdk:"private_key_attributes"`
+	PrivateKeyFlags      fwtypes.ListNestedObjectValueOf[privateKeyFlagsV3Model]    `tfsdk:"private_key_flags"`
+	SubjectNameFlags     fwtypes.ListNestedObjectValueOf[subjectNameFlagsModel]     `tfsdk:"subject_name_flags"`
+	SupersededTemplates  fwtypes.ListOfString                                       `tfsdk:"superseded_templates"`
+}
+
+type templateV4Model struct {
+	CertificateValidity  fwtypes.ListNestedObjectValueOf[certificateValidityModel]  `tfsdk:"certificate_validity"`
+	EnrollmentFlags      fwtypes.ListNestedObjectValueOf[enrollmentFlagsModel]      `tfsdk:"enrollment_flags"`
+	Extensions           fwtypes.ListNestedObjectValueOf[extensionsModel]           `tfsdk:"extensions"`
+	GeneralFlags         fwtypes.ListNestedObjectValueOf[generalFlagsModel]         `tfsdk:"general_flags"`
+	HashAlgorithm        fwtypes.StringEnum[awstypes.HashAlgorithm]                 `tfsdk:"hash_algorithm"`
+	PrivateKeyAttributes fwtypes.ListNestedObjectValueOf[privateKeyAttributesModel] `tfsdk:"private_key_attributes"`
+	PrivateKeyFlags      fwtypes.ListNestedObjectValueOf[privateKeyFlagsV4Model]    `tfsdk:"private_key_flags"`
+	SubjectNameFlags     fwtypes.ListNestedObjectValueOf[subjectNameFlagsModel]     `tfsdk:"subject_name_flags"`
+	SupersededTemplates  fwtypes.ListOfString                                       `tfsdk:"superseded_templates"`
+}
+
+type certificateValidityModel struct {
+	RenewalPeriod  fwtypes.ListNestedObjectValueOf[validityPeriodModel] `tfsdk:"renewal_period"`
+	ValidityPeriod fwtypes.ListNestedObjectValueOf[validityPeriodModel] `tfsdk:"validity_period"`
+}
+
+type validityPeriodModel struct {
+	Period     types.Int64                                     `tfsdk:"period"`
+	PeriodType fwtypes.StringEnum[awstypes.ValidityPeriodType] `tfsdk:"period_type"`
+}
+
+type enrollmentFlagsModel struct {
+	EnableKeyReuseOnNtTokenKeysetStorageFull  types.Bool `tfsdk:"enable_key_reuse_on_nt_token_keyset_storage_full"`
+	IncludeSymmetricAlgorithms                types.Bool `tfsdk:"include_symmetric_algorithms"`
+	NoSecurityExtension                       types.Bool `tfsdk:"no_security_extension"`
+	RemoveInvalidCertificateFromPersonalStore types.Bool `tfsdk:"remove_invalid_certificate_from_personal_store"`
+	UserInteractionRequired                   types.Bool `tfsdk:"user_interaction_required"`
+}
+
+type extensionsModel struct {
+	ApplicationPolicies fwtypes.ListNestedObjectValueOf[applicationPoliciesModel] `tfsdk:"application_policies"`
+	KeyUsage            fwtypes.ListNestedObjectValueOf[keyUsageModel]            `tfsdk:"key_usage"`
+}
+
+type applicationPoliciesModel struct {
+	Critical types.Bool                                              `tfsdk:"critical"`
+	Policies fwtypes.ListNestedObjectValueOf[applicationPolicyModel] `tfsdk:"policy"`
+}
+
+type applicationPolicyModel struct {
+	PolicyObjectIdentifier types.String                                       `tfsdk:"policy_object_identifier"`
+	PolicyType             fwtypes.StringEnum[awstypes.ApplicationPolicyType] `tfsdk:"policy_type"`
+}
+
+var (
+	_ fwflex.Expander  = applicationPolicyModel{}
+	_ fwflex.Flattener = &applicationPolicyModel{}
+)
+
+func (m applicationPolicyModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.PolicyObjectIdentifier.IsNull():
+		return &awstypes.ApplicationPolicyMemberPolicyObjectIdentifier{
+			Value: fwflex.StringValueFromFramework(ctx, m.PolicyObjectIdentifier),
+		}, diags
+
+	case !m.PolicyType.IsNull():
+		return &awstypes.ApplicationPolicyMemberPolicyType{
+			Value: m.PolicyType.ValueEnum(),
+		}, diags
+	}
+
+	return nil, diags
+}
+
+func (m *applicationPolicyModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.ApplicationPolicyMemberPolicyObjectIdentifier:
+		m.PolicyObjectIdentifier = fwflex.StringValueToFramework(ctx, t.Value)
+
+	case awstypes.ApplicationPolicyMemberPolicyType:
+		m.PolicyType = fwtypes.StringEnumValue(t.Value)
+	}
+
+	return diags
+}
+
+type keyUsageModel struct {
+	Critical   types.Bool                                          `tfsdk:"critical"`
+	UsageFlags fwtypes.ListNestedObjectValueOf[keyUsageFlagsModel] `tfsdk:"usage_flags"`
+}
+
+type keyUsageFlagsModel struct {
+	DataEncipherment types.Bool `tfsdk:"data_encipherment"`
+	DigitalSignature types.Bool `tfsdk:"digital_signature"`
+	KeyAgreement     types.Bool `tfsdk:"key_agreement"`
+	KeyEncipherment  types.Bool `tfsdk:"key_encipherment"`
+	NonRepudiation   types.Bool `tfsdk:"non_repudiation"`
+}
+
+type generalFlagsModel struct {
+	AutoEnrollment types.Bool `tfsdk:"auto_enrollment"`
+	MachineType    types.Bool `tfsdk:"machine_type"`
+}
+
+type privateKeyAttributesV2Model struct {
+	CryptoProviders  fwtypes.ListOfString                 `tfsdk:"crypto_providers"`
+	KeySpec          fwtypes.StringEnum[awstypes.KeySpec] `tfsdk:"key_spec"`
+	MinimalKeyLength types.Int32                          `tfsdk:"minimal_key_length"`
+}
+
+type privateKeyAttributesModel struct {
+	Algorithm        fwtypes.StringEnum[awstypes.PrivateKeyAlgorithm]       `tfsdk:"algorithm"`
+	CryptoProviders  fwtypes.ListOfString                                   `tfsdk:"crypto_providers"`
+	KeySpec          fwtypes.StringEnum[awstypes.KeySpec]                   `tfsdk:"key_spec"`
+	KeyUsageProperty fwtypes.ListNestedObjectValueOf[keyUsagePropertyModel] `tfsdk:"key_usage_property"`
+	MinimalKeyLength types.Int32                                            `tfsdk:"minimal_key_length"`
+}
+
+type keyUsagePropertyModel struct {
+	PropertyFlags fwtypes.ListNestedObjectValueOf[keyUsagePropertyFlagsModel] `tfsdk:"property_flags"`
+	PropertyType  fwtypes.StringEnum[awstypes.KeyUsagePropertyType]           `tfsdk:"property_type"`
+}
+
+var (
+	_ fwflex.Expander  = keyUsagePropertyModel{}
+	_ fwflex.Flattener = &keyUsagePropertyModel{}
+)
+
+func (m keyUsagePropertyModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	propertyFlagsData, d := m.PropertyFlags.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	switch {
+	case propertyFlagsData != nil:
+		var r awstypes.KeyUsagePropertyMemberPropertyFlags
+		diags.Append(fwflex.Expand(ctx, propertyFlagsData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.PropertyType.IsNull():
+		return &awstypes.KeyUsagePropertyMemberPropertyType{
+			Value: m.PropertyType.ValueEnum(),
+		}, diags
+	}
+
+	return nil, diags
+}
+
+func (m *keyUsagePropertyModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.KeyUsagePropertyMemberPropertyFlags:
+		var model keyUsagePropertyFlagsModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.PropertyFlags = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+	case awstypes.KeyUsagePropertyMemberPropertyType:
+		m.PropertyType = fwtypes.StringEnumValue(t.Value)
+	}
+
+	return diags
+}
+
+type keyUsagePropertyFlagsModel struct {
+	Decrypt      types.Bool `tfsdk:"decrypt"`
+	KeyAgreement types.Bool `tfsdk:"key_agreement"`
+	Sign         types.Bool `tfsdk:"sign"`
+}
+
+type privateKeyFlagsV2Model struct {
+	ClientVersion               fwtypes.StringEnum[awstypes.ClientCompatibilityV2] `tfsdk:"client_version"`
+	ExportableKey               types.Bool                                         `tfsdk:"exportable_key"`
+	StrongKeyProtectionRequired types.Bool                                         `tfsdk:"strong_key_protection_required"`
+}
+
+type privateKeyFlagsV3Model struct {
+	ClientVersion                      fwtypes.StringEnum[awstypes.ClientCompatibilityV3] `tfsdk:"client_version"`
+	ExportableKey                      types.Bool                                         `tfsdk:"exportable_key"`
+	RequireAlternateSignatureAlgorithm types.Bool                                         `tfsdk:"require_alternate_signature_algorithm"`
+	StrongKeyProtectionRequired        types.Bool                                         `tfsdk:"strong_key_protection_required"`
+}
+
+type privateKeyFlagsV4Model struct {
+	ClientVersion                      fwtypes.StringEnum[awstypes.ClientCompatibilityV4] `tfsdk:"client_version"`
+	ExportableKey                      types.Bool                                         `tfsdk:"exportable_key"`
+	RequireAlternateSignatureAlgorithm types.Bool                                         `tfsdk:"require_alternate_signature_algorithm"`
+	RequireSameKeyRenewal              types.Bool                                         `tfsdk:"require_same_key_renewal"`
+	StrongKeyProtectionRequired        types.Bool                                         `tfsdk:"strong_key_protection_required"`
+	UseLegacyProvider                  types.Bool                                         `tfsdk:"use_legacy_provider"`
+}
+
+type subjectNameFlagsModel struct {
+	RequireCommonName       types.Bool `tfsdk:"require_common_name"`
+	RequireDirectoryPath    types.Bool `tfsdk:"require_directory_path"`
+	RequireDNSAsCN          types.Bool `tfsdk:"require_dns_as_cn"`
+	RequireEmail            types.Bool `tfsdk:"require_email"`
+	SANRequireDirectoryGUID types.Bool `tfsdk:"san_require_directory_guid"`
+	SANRequireDNS           types.Bool `tfsdk:"san_require_dns"`
+	SANRequireDomainDNS     types.Bool `tfsdk:"san_require_domain_dns"`
+	SANRequireEmail         types.Bool `tfsdk:"san_require_email"`
+	SANRequireSPN           types.Bool `tfsdk:"san_require_spn"`
+	SANRequireUPN           types.Bool `tfsdk:"san_require_upn"`
+}
@@ -0,0 +1,302 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package pcaconnectorad
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/YakDriver/smarterr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pcaconnectorad"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pcaconnectorad/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// @FrameworkResource("aws_pcaconnectorad_template_group_access_control_entry", name="Template Group Access Control Entry")
+// @IdentityAttribute("template_arn")
+// @IdentityAttribute("group_security_identifier")
+// @ImportIDHandler("templateGroupAccessControlEntryImportID")
+func newTemplateGroupAccessControlEntryResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &templateGroupAccessControlEntryResource{}, nil
+}
+
+type templateGroupAccessControlEntryResource struct {
+	framework.ResourceWithModel[templateGroupAccessControlEntryResourceModel]
+	framework.WithImportByIdentity
+}
+
+func (r *templateGroupAccessControlEntryResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"group_display_name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+				},
+			},
+			"group_security_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`^S-[0-9]-([0-9]+-){1,14}[0-9]+$`), "must be a valid security identifier (SID)"),
+				},
+			},
+			"template_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"access_rights": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[accessRightsModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"auto_enroll": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.AccessRight](),
+							Optional:   true,
+							Computed:   true,
+						},
+						"enroll": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.AccessRight](),
+							Optional:   true,
+							Computed:   true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *templateGroupAccessControlEntryResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data templateGroupAccessControlEntryResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCAConnectorADClient(ctx)
+
+	templateARN, groupSecurityIdentifier := fwflex.StringValueFromFramework(ctx, data.TemplateARN), fwflex.StringValueFromFramework(ctx, data.GroupSecurityIdentifier)
+	id := templateGroupAccessControlEntryCreateResourceID(templateARN, groupSecurityIdentifier)
+	var input pcaconnectorad.CreateTemplateGroupAccessControlEntryInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, data, &input))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(sdkid.UniqueId())
+
+	_, err := conn.CreateTemplateGroupAccessControlEntry(ctx, &input)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+
+	output, err := findTemplateGroupAccessControlEntryByTwoPartKey(ctx, conn, templateARN, groupSecurityIdentifier)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, data))
+}
+
+func (r *templateGroupAccessControlEntryResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data templateGroupAccessControlEntryResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCAConnectorADClient(ctx)
+
+	templateARN, groupSecurityIdentifier := fwflex.StringValueFromFramework(ctx, data.TemplateARN), fwflex.StringValueFromFramework(ctx, data.GroupSecurityIdentifier)
+	id := templateGroupAccessControlEntryCreateResourceID(templateARN, groupSecurityIdentifier)
+	output, err := findTemplateGroupAccessControlEntryByTwoPartKey(ctx, conn, templateARN, groupSecurityIdentifier)
+	if retry.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &data))
+}
+
+func (r *templateGroupAccessControlEntryResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan, state templateGroupAccessControlEntryResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Plan.Get(ctx, &plan))
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &state))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCAConnectorADClient(ctx)
+
+	templateARN, groupSecurityIdentifier := fwflex.StringValueFromFramework(ctx, plan.TemplateARN), fwflex.StringValueFromFramework(ctx, plan.GroupSecurityIdentifier)
+	id := templateGroupAccessControlEntryCreateResourceID(templateARN, groupSecurityIdentifier)
+
+	diff, d := fwflex.Diff(ctx, plan, state)
+	smerr.AddEnrich(ctx, &response.Diagnostics, d)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		var input pcaconnectorad.UpdateTemplateGroupAccessControlEntryInput
+		smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, plan, &input))
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateTemplateGroupAccessControlEntry(ctx, &input)
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+			return
+		}
+
+		output, err := findTemplateGroupAccessControlEntryByTwoPartKey(ctx, conn, templateARN, groupSecurityIdentifier)
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+			return
+		}
+
+		smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, output, &plan))
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.State.Set(ctx, &plan))
+}
+
+func (r *templateGroupAccessControlEntryResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data templateGroupAccessControlEntryResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.State.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCAConnectorADClient(ctx)
+
+	templateARN, groupSecurityIdentifier := fwflex.StringValueFromFramework(ctx, data.TemplateARN), fwflex.StringValueFromFramework(ctx, data.GroupSecurityIdentifier)
+	id := templateGroupAccessControlEntryCreateResourceID(templateARN, groupSecurityIdentifier)
+	input := pcaconnectorad.DeleteTemplateGroupAccessControlEntryInput{
+		GroupSecurityIdentifier: aws.String(groupSecurityIdentifier),
+		TemplateArn:             aws.String(templateARN),
+	}
+	_, err := conn.DeleteTemplateGroupAccessControlEntry(ctx, &input)
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, id)
+		return
+	}
+}
+
+func templateGroupAccessControlEntryCreateResourceID(templateARN, groupSecurityIdentifier string) string {
+	return templateARN + intflex.ResourceIdSeparator + groupSecurityIdentifier
+}
+
+var _ inttypes.ImportIDParser = templateGroupAccessControlEntryImportID{}
+
+type templateGroupAccessControlEntryImportID struct{}
+
+func (templateGroupAccessControlEntryImportID) Parse(id string) (string, map[string]string, error) {
+	templateARN, groupSecurityIdentifier, found := strings.Cut(id, intflex.ResourceIdSeparator)
+	if !found {
+		return "", nil, fmt.Errorf("id \"%s\" should be in the format <template-arn>"+intflex.ResourceIdSeparator+"<group-security-identifier>", id)
+	}
+
+	result := map[string]string{
+		"group_security_identifier": groupSecurityIdentifier,
+		"template_arn":              templateARN,
+	}
+
+	return id, result, nil
+}
+
+func findTemplateGroupAccessControlEntryByTwoPartKey(ctx context.Context, conn *pcaconnectorad.Client, templateARN, groupSecurityIdentifier string) (*awstypes.AccessControlEntry, error) {
+	input := pcaconnectorad.GetTemplateGroupAccessControlEntryInput{
+		GroupSecurityIdentifier: aws.String(groupSecurityIdentifier),
+		TemplateArn:             aws.String(templateARN),
+	}
+	output, err := conn.GetTemplateGroupAccessControlEntry(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, smarterr.NewError(&retry.NotFoundError{
+			LastError: err,
+		})
+	}
+
+	if err != nil {
+		return nil, smarterr.NewError(err)
+	}
+
+	if output == nil || output.AccessControlEntry == nil {
+		return nil, smarterr.NewError(tfresource.NewEmptyResultError())
+	}
+
+	return output.AccessControlEntry, nil
+}
+
+type templateGroupAccessControlEntryResourceModel struct {
+	framework.WithRegionModel
+	AccessRights            fwtypes.ListNestedObjectValueOf[accessRightsModel] `tfsdk:"access_rights"`
+	GroupDisplayName        types.String                                       `tfsdk:"group_display_name"`
+	GroupSecurityIdentifier types.String                                       `tfsdk:"group_security_identifier"`
+	TemplateARN             fwtypes.ARN                                        `tfsdk:"template_arn"`
+}
+
+type accessRightsModel struct {
+	AutoEnroll fwtypes.StringEnum[awstypes.AccessRight] `tfsdk:"auto_enroll"`
+	Enroll     fwtypes.StringEnum[awstypes.AccessRight] `tfsdk:"enroll"`
+}
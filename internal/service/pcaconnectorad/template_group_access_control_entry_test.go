@@ -0,0 +1,213 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package pcaconnectorad_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pcaconnectorad/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfpcaconnectorad "github.com/hashicorp/terraform-provider-aws/internal/service/pcaconnectorad"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// The well-known "Authenticated Users" security identifier exists in every Active Directory domain.
+const testAccTemplateGroupAccessControlEntryGroupSID = "S-1-5-11"
+
+func TestAccPCAConnectorADTemplateGroupAccessControlEntry_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v awstypes.AccessControlEntry
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	domain := acctest.RandomDomainName()
+	resourceName := "aws_pcaconnectorad_template_group_access_control_entry.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.PCAConnectorADServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateGroupAccessControlEntryDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateGroupAccessControlEntryConfig_basic(rName, domain, "first", "ALLOW"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTemplateGroupAccessControlEntryExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "template_arn", "aws_pcaconnectorad_template.test", names.AttrARN),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("access_rights"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"auto_enroll": knownvalue.StringExact(string(awstypes.AccessRightDeny)),
+							"enroll":      knownvalue.StringExact(string(awstypes.AccessRightAllow)),
+						}),
+					})),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("group_display_name"), knownvalue.StringExact("first")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("group_security_identifier"), knownvalue.StringExact(testAccTemplateGroupAccessControlEntryGroupSID)),
+				},
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "template_arn",
+				ImportStateIdFunc:                    acctest.AttrsImportStateIdFunc(resourceName, ",", "template_arn", "group_security_identifier"),
+			},
+		},
+	})
+}
+
+func TestAccPCAConnectorADTemplateGroupAccessControlEntry_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v awstypes.AccessControlEntry
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	domain := acctest.RandomDomainName()
+	resourceName := "aws_pcaconnectorad_template_group_access_control_entry.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.PCAConnectorADServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateGroupAccessControlEntryDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateGroupAccessControlEntryConfig_basic(rName, domain, "first", "ALLOW"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTemplateGroupAccessControlEntryExists(ctx, t, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, t, tfpcaconnectorad.ResourceTemplateGroupAccessControlEntry, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccPCAConnectorADTemplateGroupAccessControlEntry_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v awstypes.AccessControlEntry
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	domain := acctest.RandomDomainName()
+	resourceName := "aws_pcaconnectorad_template_group_access_control_entry.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.PCAConnectorADServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateGroupAccessControlEntryDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateGroupAccessControlEntryConfig_basic(rName, domain, "first", "ALLOW"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTemplateGroupAccessControlEntryExists(ctx, t, resourceName, &v),
+				),
+			},
+			{
+				Config: testAccTemplateGroupAccessControlEntryConfig_basic(rName, domain, "second", "DENY"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTemplateGroupAccessControlEntryExists(ctx, t, resourceName, &v),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("access_rights"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"auto_enroll": knownvalue.StringExact(string(awstypes.AccessRightDeny)),
+							"enroll":      knownvalue.StringExact(string(awstypes.AccessRightDeny)),
+						}),
+					})),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("group_display_name"), knownvalue.StringExact("second")),
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckTemplateGroupAccessControlEntryDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).PCAConnectorADClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_pcaconnectorad_template_group_access_control_entry" {
+				continue
+			}
+
+			_, err := tfpcaconnectorad.FindTemplateGroupAccessControlEntryByTwoPartKey(ctx, conn, rs.Primary.Attributes["template_arn"], rs.Primary.Attributes["group_security_identifier"])
+
+			if retry.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("PCA Connector for AD Template Group Access Control Entry %s still exists", rs.Primary.Attributes["group_security_identifier"])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTemplateGroupAccessControlEntryExists(ctx context.Context, t *testing.T, n string, v *awstypes.AccessControlEntry) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).PCAConnectorADClient(ctx)
+
+		output, err := tfpcaconnectorad.FindTemplateGroupAccessControlEntryByTwoPartKey(ctx, conn, rs.Primary.Attributes["template_arn"], rs.Primary.Attributes["group_security_identifier"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccTemplateGroupAccessControlEntryConfig_basic(rName, domain, groupDisplayName, enroll string) string {
+	return acctest.ConfigCompose(testAccTemplateConfig_basic(rName, domain), fmt.Sprintf(`
+resource "aws_pcaconnectorad_template_group_access_control_entry" "test" {
+  group_display_name        = %[1]q
+  group_security_identifier = %[2]q
+  template_arn              = aws_pcaconnectorad_template.test.arn
+
+  access_rights {
+    auto_enroll = "DENY"
+    enroll      = %[3]q
+  }
+}
+`, groupDisplayName, testAccTemplateGroupAccessControlEntryGroupSID, enroll))
+}
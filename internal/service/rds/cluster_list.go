@@ -0,0 +1,136 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	"github.com/hashicorp/terraform-provider-aws/internal/namevaluesfilters"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_rds_cluster")
+func newClusterResourceAsListResource() inttypes.ListResourceForSDK {
+	l := listResourceCluster{}
+	l.SetResourceSchema(resourceCluster())
+	return &l
+}
+
+var _ list.ListResource = &listResourceCluster{}
+
+type listResourceCluster struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type listClusterModel struct {
+	framework.WithRegionModel
+	Engine types.String `tfsdk:"engine"`
+}
+
+func (l *listResourceCluster) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			names.AttrEngine: listschema.StringAttribute{
+				Optional:    true,
+				Description: "Name of the database engine to list DB clusters for.",
+			},
+		},
+	}
+}
+
+func (l *listResourceCluster) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var query listClusterModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	awsClient := l.Meta()
+	conn := awsClient.RDSClient(ctx)
+
+	tflog.Info(ctx, "Listing RDS Clusters")
+	stream.Results = func(yield func(list.ListResult) bool) {
+		input := rds.DescribeDBClustersInput{
+			Filters: namevaluesfilters.New(map[string]string{
+				"engine": query.Engine.ValueString(),
+			}).RDSFilters(),
+		}
+		for item, err := range listDBClusters(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			id := aws.ToString(item.DBClusterIdentifier)
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), id)
+
+			result := request.NewListResult(ctx)
+			rd := l.ResourceData()
+			rd.SetId(id)
+			rd.Set("cluster_identifier", id)
+
+			tflog.Info(ctx, "Reading RDS Cluster")
+			diags := resourceClusterRead(ctx, rd, awsClient)
+			if diags.HasError() {
+				tflog.Error(ctx, "Reading RDS Cluster", map[string]any{
+					names.AttrID: id,
+					"diags":      sdkdiag.DiagnosticsString(diags),
+				})
+				continue
+			}
+			if rd.Id() == "" {
+				// Resource is logically deleted
+				continue
+			}
+
+			result.DisplayName = id
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+func listDBClusters(ctx context.Context, conn *rds.Client, input *rds.DescribeDBClustersInput) iter.Seq2[awstypes.DBCluster, error] {
+	return func(yield func(awstypes.DBCluster, error) bool) {
+		pages := rds.NewDescribeDBClustersPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.DBCluster{}, fmt.Errorf("listing RDS Clusters: %w", err))
+				return
+			}
+
+			for _, item := range page.DBClusters {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_rds_cluster_parameter_group")
+func newClusterParameterGroupResourceAsListResource() inttypes.ListResourceForSDK {
+	l := listResourceClusterParameterGroup{}
+	l.SetResourceSchema(resourceClusterParameterGroup())
+	return &l
+}
+
+var _ list.ListResource = &listResourceClusterParameterGroup{}
+
+type listResourceClusterParameterGroup struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type listClusterParameterGroupModel struct {
+	framework.WithRegionModel
+}
+
+func (l *listResourceClusterParameterGroup) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var query listClusterParameterGroupModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	awsClient := l.Meta()
+	conn := awsClient.RDSClient(ctx)
+
+	tflog.Info(ctx, "Listing RDS Cluster Parameter Group Parameter Groups")
+	stream.Results = func(yield func(list.ListResult) bool) {
+		var input rds.DescribeDBClusterParameterGroupsInput
+		for item, err := range listDBClusterParameterGroups(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			id := aws.ToString(item.DBClusterParameterGroupName)
+
+			// Default parameter groups are managed by AWS and can't be modified or deleted.
+			if strings.HasPrefix(id, "default.") {
+				continue
+			}
+
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), id)
+
+			result := request.NewListResult(ctx)
+			rd := l.ResourceData()
+			rd.SetId(id)
+			rd.Set(names.AttrName, id)
+
+			tflog.Info(ctx, "Reading RDS Cluster Parameter Group")
+			diags := resourceClusterParameterGroupRead(ctx, rd, awsClient)
+			if diags.HasError() {
+				tflog.Error(ctx, "Reading RDS Cluster Parameter Group", map[string]any{
+					names.AttrID: id,
+					"diags":      sdkdiag.DiagnosticsString(diags),
+				})
+				continue
+			}
+			if rd.Id() == "" {
+				// Resource is logically deleted
+				continue
+			}
+
+			result.DisplayName = id
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+func listDBClusterParameterGroups(ctx context.Context, conn *rds.Client, input *rds.DescribeDBClusterParameterGroupsInput) iter.Seq2[awstypes.DBClusterParameterGroup, error] {
+	return func(yield func(awstypes.DBClusterParameterGroup, error) bool) {
+		pages := rds.NewDescribeDBClusterParameterGroupsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.DBClusterParameterGroup{}, fmt.Errorf("listing RDS Cluster Parameter Group Parameter Groups: %w", err))
+				return
+			}
+
+			for _, item := range page.DBClusterParameterGroups {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -22,6 +22,7 @@ const (
 	clusterStatusConfiguringIAMDatabaseAuth    = "configuring-iam-database-auth"
 	clusterStatusCreating                      = "creating"
 	clusterStatusDeleting                      = "deleting"
+	clusterStatusFailingOver                   = "failing-over"
 	clusterStatusMigrating                     = "migrating"
 	clusterStatusModifying                     = "modifying"
 	clusterStatusPreparingDataMigration        = "preparing-data-migration"
@@ -35,6 +36,8 @@ const (
 
 	// Non-standard status values.
 	clusterStatusAvailableWithPendingModifiedValues = "tf-available-with-pending-modified-values"
+	clusterStatusFailoverNotStarted                 = "tf-failover-not-started"
+	clusterStatusFailoverPending                    = "tf-failover-pending"
 )
 
 const (
@@ -0,0 +1,174 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	createDBClusterSnapshotPollInterval   = 30 * time.Second
+	createDBClusterSnapshotDefaultTimeout = 60 * time.Minute
+)
+
+// @Action(aws_rds_create_db_cluster_snapshot, name="Create DB Cluster Snapshot")
+func newCreateDBClusterSnapshotAction(context.Context) (action.ActionWithConfigure, error) {
+	return &createDBClusterSnapshotAction{}, nil
+}
+
+var (
+	_ action.Action = (*createDBClusterSnapshotAction)(nil)
+)
+
+type createDBClusterSnapshotAction struct {
+	framework.ActionWithModel[createDBClusterSnapshotActionModel]
+}
+
+type createDBClusterSnapshotActionModel struct {
+	framework.WithRegionModel
+	DBClusterIdentifier         types.String `tfsdk:"db_cluster_identifier"`
+	DBClusterSnapshotIdentifier types.String `tfsdk:"db_cluster_snapshot_identifier"`
+	Tags                        tftags.Map   `tfsdk:"tags"`
+	Timeout                     types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *createDBClusterSnapshotAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates a manual snapshot of an RDS DB cluster and waits for it to become available.",
+		Attributes: map[string]schema.Attribute{
+			"db_cluster_identifier": schema.StringAttribute{
+				Description: "Identifier of the DB cluster to snapshot",
+				Required:    true,
+			},
+			"db_cluster_snapshot_identifier": schema.StringAttribute{
+				Description: "Identifier of the DB cluster snapshot to create",
+				Required:    true,
+			},
+			names.AttrTags: schema.MapAttribute{
+				CustomType:  tftags.MapType,
+				ElementType: types.StringType,
+				Description: "Tags to assign to the DB cluster snapshot",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the snapshot to become available (default: 3600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(86400),
+				},
+			},
+		},
+	}
+}
+
+func (a *createDBClusterSnapshotAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config createDBClusterSnapshotActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().RDSClient(ctx)
+
+	clusterID := config.DBClusterIdentifier.ValueString()
+	snapshotID := config.DBClusterSnapshotIdentifier.ValueString()
+
+	timeout := createDBClusterSnapshotDefaultTimeout
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Creating RDS DB cluster snapshot", map[string]any{
+		"db_cluster_identifier":          clusterID,
+		"db_cluster_snapshot_identifier": snapshotID,
+		names.AttrTimeout:                timeout.String(),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Creating snapshot %s of RDS DB cluster %s...", snapshotID, clusterID),
+	})
+
+	input := rds.CreateDBClusterSnapshotInput{
+		DBClusterIdentifier:         aws.String(clusterID),
+		DBClusterSnapshotIdentifier: aws.String(snapshotID),
+		Tags:                        svcTags(tftags.New(ctx, config.Tags)),
+	}
+	if _, err := conn.CreateDBClusterSnapshot(ctx, &input); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("creating RDS Cluster (%s) Snapshot (%s)", clusterID, snapshotID), err.Error())
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Snapshot %s started, waiting for it to become available...", snapshotID),
+	})
+
+	// Snapshot status and progress are tracked between polls so that each change is reported exactly once.
+	var lastStatus string
+	var lastPercentProgress int32
+	fr, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.DBClusterSnapshot], error) {
+		output, err := findDBClusterSnapshotByID(ctx, conn, snapshotID)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.DBClusterSnapshot]{}, err
+		}
+
+		status, percentProgress := aws.ToString(output.Status), aws.ToInt32(output.PercentProgress)
+		if status != lastStatus || percentProgress != lastPercentProgress {
+			lastStatus, lastPercentProgress = status, percentProgress
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Snapshot %s is %s (%d%%)", snapshotID, status, percentProgress),
+			})
+		}
+
+		return actionwait.FetchResult[*awstypes.DBClusterSnapshot]{Status: actionwait.Status(status), Value: output}, nil
+	}, actionwait.Options[*awstypes.DBClusterSnapshot]{
+		Timeout:  timeout,
+		Interval: actionwait.FixedInterval(createDBClusterSnapshotPollInterval),
+		SuccessStates: []actionwait.Status{
+			clusterSnapshotStatusAvailable,
+		},
+		TransitionalStates: []actionwait.Status{
+			clusterSnapshotStatusCreating,
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Snapshot",
+				fmt.Sprintf("RDS Cluster Snapshot (%s) did not become available within %s (last status: %s)", snapshotID, timeout, timeoutErr.LastStatus),
+			)
+		} else {
+			resp.Diagnostics.AddError(fmt.Sprintf("waiting for RDS Cluster Snapshot (%s) create", snapshotID), err.Error())
+		}
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Snapshot %s of RDS DB cluster %s is available", snapshotID, clusterID),
+	})
+
+	tflog.Info(ctx, "RDS DB cluster snapshot created", map[string]any{
+		"db_cluster_identifier":      clusterID,
+		"db_cluster_snapshot_arn":    aws.ToString(fr.Value.DBClusterSnapshotArn),
+		"db_cluster_snapshot_engine": aws.ToString(fr.Value.Engine),
+	})
+}
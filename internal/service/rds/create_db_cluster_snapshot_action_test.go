@@ -0,0 +1,101 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfrds "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRDSCreateDBClusterSnapshotAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	// The snapshot is created outside of Terraform's management.
+	t.Cleanup(func() {
+		conn := acctest.ProviderMeta(ctx, t).RDSClient(ctx)
+		input := rds.DeleteDBClusterSnapshotInput{
+			DBClusterSnapshotIdentifier: aws.String(rName),
+		}
+		conn.DeleteDBClusterSnapshot(ctx, &input) //nolint:errcheck // best-effort cleanup
+	})
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCreateDBClusterSnapshotActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterSnapshotCreatedByAction(ctx, t, rName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckClusterSnapshotCreatedByAction(ctx context.Context, t *testing.T, id string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).RDSClient(ctx)
+
+		output, err := tfrds.FindDBClusterSnapshotByID(ctx, conn, id)
+
+		if err != nil {
+			return err
+		}
+
+		if got, want := aws.ToString(output.Status), "available"; got != want {
+			return fmt.Errorf("RDS Cluster Snapshot (%s) status = %s, want %s", id, got, want)
+		}
+
+		for _, tag := range output.TagList {
+			if aws.ToString(tag.Key) == "Purpose" && aws.ToString(tag.Value) == "maintenance" {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("RDS Cluster Snapshot (%s) is missing tag Purpose=maintenance", id)
+	}
+}
+
+func testAccCreateDBClusterSnapshotActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccClusterSnapshotConfig_base(rName), fmt.Sprintf(`
+action "aws_rds_create_db_cluster_snapshot" "test" {
+  config {
+    db_cluster_identifier          = aws_rds_cluster.test.id
+    db_cluster_snapshot_identifier = %[1]q
+
+    tags = {
+      Purpose = "maintenance"
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_rds_cluster.test.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_rds_create_db_cluster_snapshot.test]
+    }
+  }
+}
+`, rName))
+}
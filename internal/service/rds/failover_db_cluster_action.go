@@ -0,0 +1,255 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	failoverDBClusterPollInterval   = 15 * time.Second
+	failoverDBClusterDefaultTimeout = 30 * time.Minute
+
+	// Maximum time the cluster may remain available with the previous writer after the failover is requested.
+	failoverDBClusterPendingTimeout = 5 * time.Minute
+)
+
+// @Action(aws_rds_failover_db_cluster, name="Failover DB Cluster")
+func newFailoverDBClusterAction(context.Context) (action.ActionWithConfigure, error) {
+	return &failoverDBClusterAction{}, nil
+}
+
+var (
+	_ action.Action = (*failoverDBClusterAction)(nil)
+)
+
+type failoverDBClusterAction struct {
+	framework.ActionWithModel[failoverDBClusterActionModel]
+}
+
+type failoverDBClusterActionModel struct {
+	framework.WithRegionModel
+	DBClusterIdentifier        types.String `tfsdk:"db_cluster_identifier"`
+	TargetDBInstanceIdentifier types.String `tfsdk:"target_db_instance_identifier"`
+	Timeout                    types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *failoverDBClusterAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Forces a failover of an RDS DB cluster and waits for the new writer to become available.",
+		Attributes: map[string]schema.Attribute{
+			"db_cluster_identifier": schema.StringAttribute{
+				Description: "Identifier of the DB cluster to fail over",
+				Required:    true,
+			},
+			"target_db_instance_identifier": schema.StringAttribute{
+				Description: "Identifier of the reader DB instance to promote to the writer. If omitted, RDS chooses the reader",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the failover to complete (default: 1800)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(7200),
+				},
+			},
+		},
+	}
+}
+
+func (a *failoverDBClusterAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config failoverDBClusterActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().RDSClient(ctx)
+
+	id := config.DBClusterIdentifier.ValueString()
+	targetID := config.TargetDBInstanceIdentifier.ValueString()
+
+	timeout := failoverDBClusterDefaultTimeout
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	cluster, err := findDBClusterByID(ctx, conn, id)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("reading RDS Cluster (%s)", id), err.Error())
+		return
+	}
+
+	// The failover has completed once a different instance is the cluster's writer.
+	previousWriterID := dbClusterWriterInstanceID(cluster)
+
+	if len(cluster.DBClusterMembers) < 2 {
+		resp.Diagnostics.AddError(
+			"Failover Not Possible",
+			fmt.Sprintf("RDS Cluster (%s) must have at least one reader DB instance to fail over to", id),
+		)
+		return
+	}
+	if targetID != "" && !slices.ContainsFunc(cluster.DBClusterMembers, func(v awstypes.DBClusterMember) bool {
+		return aws.ToString(v.DBInstanceIdentifier) == targetID
+	}) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("target_db_instance_identifier"),
+			"Failover Not Possible",
+			fmt.Sprintf("DB instance (%s) is not a member of RDS Cluster (%s)", targetID, id),
+		)
+		return
+	}
+	if targetID != "" && targetID == previousWriterID {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("target_db_instance_identifier"),
+			"Failover Not Possible",
+			fmt.Sprintf("DB instance (%s) is already the writer of RDS Cluster (%s)", targetID, id),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "Failing over RDS DB cluster", map[string]any{
+		"db_cluster_identifier":         id,
+		"previous_writer":               previousWriterID,
+		"target_db_instance_identifier": targetID,
+		names.AttrTimeout:               timeout.String(),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Failing over RDS DB cluster %s from writer %s...", id, previousWriterID),
+	})
+
+	input := rds.FailoverDBClusterInput{
+		DBClusterIdentifier: aws.String(id),
+	}
+	if targetID != "" {
+		input.TargetDBInstanceIdentifier = aws.String(targetID)
+	}
+	if _, err := conn.FailoverDBCluster(ctx, &input); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failing over RDS Cluster (%s)", id), err.Error())
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("RDS DB cluster %s failover started, waiting for completion...", id),
+	})
+
+	// Cluster status is tracked between polls so that each transition is reported exactly once.
+	var lastStatus actionwait.Status
+	var pendingSince time.Time
+	fr, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.DBCluster], error) {
+		output, err := findDBClusterByID(ctx, conn, id)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.DBCluster]{}, err
+		}
+
+		status := actionwait.Status(aws.ToString(output.Status))
+		// The cluster can still be available with the previous writer immediately after FailoverDBCluster returns.
+		// If RDS never starts the failover, give up rather than waiting for the full timeout.
+		if status == clusterStatusAvailable && dbClusterWriterInstanceID(output) == previousWriterID {
+			if pendingSince.IsZero() {
+				pendingSince = time.Now()
+			}
+			status = clusterStatusFailoverPending
+			if time.Since(pendingSince) > failoverDBClusterPendingTimeout {
+				status = clusterStatusFailoverNotStarted
+			}
+		} else {
+			pendingSince = time.Time{}
+		}
+
+		if status != lastStatus {
+			lastStatus = status
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("RDS DB cluster %s is %s", id, status),
+			})
+		}
+
+		return actionwait.FetchResult[*awstypes.DBCluster]{Status: status, Value: output}, nil
+	}, actionwait.Options[*awstypes.DBCluster]{
+		Timeout:  timeout,
+		Interval: actionwait.FixedInterval(failoverDBClusterPollInterval),
+		SuccessStates: []actionwait.Status{
+			clusterStatusAvailable,
+		},
+		TransitionalStates: []actionwait.Status{
+			clusterStatusBackingUp,
+			clusterStatusFailingOver,
+			clusterStatusFailoverPending,
+			clusterStatusModifying,
+			clusterStatusRebooting,
+		},
+		FailureStates: []actionwait.Status{
+			clusterStatusFailoverNotStarted,
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Failover",
+				fmt.Sprintf("RDS Cluster (%s) failover did not complete within %s (last status: %s)", id, timeout, timeoutErr.LastStatus),
+			)
+		} else if errors.As(err, &failureErr) {
+			resp.Diagnostics.AddError(
+				"Failover Not Started",
+				fmt.Sprintf("RDS Cluster (%s) is still available with writer %s %s after the failover was requested", id, previousWriterID, failoverDBClusterPendingTimeout),
+			)
+		} else {
+			resp.Diagnostics.AddError(fmt.Sprintf("waiting for RDS Cluster (%s) failover", id), err.Error())
+		}
+		return
+	}
+
+	writerID := dbClusterWriterInstanceID(fr.Value)
+
+	if targetID != "" && writerID != targetID {
+		resp.Diagnostics.AddError(
+			"Failover Target Not Promoted",
+			fmt.Sprintf("RDS Cluster (%s) failed over to DB instance %s instead of %s", id, writerID, targetID),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("RDS DB cluster %s failed over, writer is now %s", id, writerID),
+	})
+
+	tflog.Info(ctx, "RDS DB cluster failed over", map[string]any{
+		"db_cluster_identifier": id,
+		"previous_writer":       previousWriterID,
+		"writer":                writerID,
+	})
+}
+
+func dbClusterWriterInstanceID(cluster *awstypes.DBCluster) string {
+	for _, v := range cluster.DBClusterMembers {
+		if aws.ToBool(v.IsClusterWriter) {
+			return aws.ToString(v.DBInstanceIdentifier)
+		}
+	}
+
+	return ""
+}
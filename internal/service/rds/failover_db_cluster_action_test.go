@@ -0,0 +1,220 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRDSFailoverDBClusterAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v types.DBCluster
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFailoverDBClusterActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, "aws_rds_cluster.test", &v),
+					testAccCheckClusterWriter(&v, "aws_rds_cluster_instance.test.1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSFailoverDBClusterAction_noReader(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFailoverDBClusterActionConfig_noReader(rName),
+				ExpectError: regexache.MustCompile(`Failover Not Possible`),
+			},
+		},
+	})
+}
+
+func TestAccRDSFailoverDBClusterAction_targetIsWriter(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFailoverDBClusterActionConfig_targetIsWriter(rName),
+				ExpectError: regexache.MustCompile(`is already the writer`),
+			},
+		},
+	})
+}
+
+func testAccCheckClusterWriter(v *types.DBCluster, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		for _, member := range v.DBClusterMembers {
+			if aws.ToBool(member.IsClusterWriter) {
+				if got, want := aws.ToString(member.DBInstanceIdentifier), rs.Primary.ID; got != want {
+					return fmt.Errorf("RDS Cluster (%s) writer = %s, want %s", aws.ToString(v.DBClusterIdentifier), got, want)
+				}
+
+				return nil
+			}
+		}
+
+		return fmt.Errorf("RDS Cluster (%s) has no writer", aws.ToString(v.DBClusterIdentifier))
+	}
+}
+
+func testAccFailoverDBClusterActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccClusterInstanceConfig_base(rName, "aurora-mysql"), fmt.Sprintf(`
+resource "aws_rds_cluster_instance" "test" {
+  count = 2
+
+  identifier         = "%[1]s-${count.index}"
+  engine             = data.aws_rds_engine_version.default.engine
+  cluster_identifier = aws_rds_cluster.test.id
+  instance_class     = data.aws_rds_orderable_db_instance.test.instance_class
+  promotion_tier     = count.index
+}
+
+action "aws_rds_failover_db_cluster" "test" {
+  config {
+    db_cluster_identifier         = aws_rds_cluster.test.id
+    target_db_instance_identifier = aws_rds_cluster_instance.test[1].identifier
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_rds_cluster_instance.test[*].id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_rds_failover_db_cluster.test]
+    }
+  }
+}
+`, rName))
+}
+
+func testAccFailoverDBClusterActionConfig_noReader(rName string) string {
+	return acctest.ConfigCompose(testAccClusterInstanceConfig_base(rName, "aurora-mysql"), fmt.Sprintf(`
+resource "aws_rds_cluster_instance" "test" {
+  identifier         = %[1]q
+  engine             = data.aws_rds_engine_version.default.engine
+  cluster_identifier = aws_rds_cluster.test.id
+  instance_class     = data.aws_rds_orderable_db_instance.test.instance_class
+}
+
+action "aws_rds_failover_db_cluster" "test" {
+  config {
+    db_cluster_identifier = aws_rds_cluster.test.id
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_rds_cluster_instance.test.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_rds_failover_db_cluster.test]
+    }
+  }
+}
+`, rName))
+}
+
+func testAccFailoverDBClusterActionConfig_targetIsWriter(rName string) string {
+	return acctest.ConfigCompose(testAccClusterInstanceConfig_base(rName, "aurora-mysql"), fmt.Sprintf(`
+resource "aws_rds_cluster_instance" "writer" {
+  identifier         = "%[1]s-writer"
+  engine             = data.aws_rds_engine_version.default.engine
+  cluster_identifier = aws_rds_cluster.test.id
+  instance_class     = data.aws_rds_orderable_db_instance.test.instance_class
+}
+
+# The first instance created in the cluster is its writer.
+resource "aws_rds_cluster_instance" "reader" {
+  identifier         = "%[1]s-reader"
+  engine             = data.aws_rds_engine_version.default.engine
+  cluster_identifier = aws_rds_cluster.test.id
+  instance_class     = data.aws_rds_orderable_db_instance.test.instance_class
+
+  depends_on = [aws_rds_cluster_instance.writer]
+}
+
+action "aws_rds_failover_db_cluster" "test" {
+  config {
+    db_cluster_identifier         = aws_rds_cluster.test.id
+    target_db_instance_identifier = aws_rds_cluster_instance.writer.identifier
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_rds_cluster_instance.reader.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_rds_failover_db_cluster.test]
+    }
+  }
+}
+`, rName))
+}
@@ -0,0 +1,142 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	"github.com/hashicorp/terraform-provider-aws/internal/namevaluesfilters"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_db_instance")
+func newInstanceResourceAsListResource() inttypes.ListResourceForSDK {
+	l := listResourceInstance{}
+	l.SetResourceSchema(resourceInstance())
+	return &l
+}
+
+var _ list.ListResource = &listResourceInstance{}
+
+type listResourceInstance struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type listInstanceModel struct {
+	framework.WithRegionModel
+	DBClusterIdentifier types.String `tfsdk:"db_cluster_identifier"`
+	Engine              types.String `tfsdk:"engine"`
+}
+
+func (l *listResourceInstance) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"db_cluster_identifier": listschema.StringAttribute{
+				Optional:    true,
+				Description: "Identifier or ARN of the DB cluster to list member DB instances of.",
+			},
+			names.AttrEngine: listschema.StringAttribute{
+				Optional:    true,
+				Description: "Name of the database engine to list DB instances for.",
+			},
+		},
+	}
+}
+
+func (l *listResourceInstance) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var query listInstanceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	awsClient := l.Meta()
+	conn := awsClient.RDSClient(ctx)
+
+	tflog.Info(ctx, "Listing RDS DB Instances")
+	stream.Results = func(yield func(list.ListResult) bool) {
+		input := rds.DescribeDBInstancesInput{
+			Filters: namevaluesfilters.New(map[string]string{
+				"db-cluster-id": query.DBClusterIdentifier.ValueString(),
+				"engine":        query.Engine.ValueString(),
+			}).RDSFilters(),
+		}
+		for item, err := range listDBInstances(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			id := aws.ToString(item.DbiResourceId)
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), id)
+
+			result := request.NewListResult(ctx)
+			rd := l.ResourceData()
+			rd.SetId(id)
+			rd.Set(names.AttrIdentifier, item.DBInstanceIdentifier)
+
+			tflog.Info(ctx, "Reading RDS DB Instance")
+			diags := resourceInstanceRead(ctx, rd, awsClient)
+			if diags.HasError() {
+				tflog.Error(ctx, "Reading RDS DB Instance", map[string]any{
+					names.AttrID: id,
+					"diags":      sdkdiag.DiagnosticsString(diags),
+				})
+				continue
+			}
+			if rd.Id() == "" {
+				// Resource is logically deleted
+				continue
+			}
+
+			result.DisplayName = aws.ToString(item.DBInstanceIdentifier)
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+func listDBInstances(ctx context.Context, conn *rds.Client, input *rds.DescribeDBInstancesInput) iter.Seq2[awstypes.DBInstance, error] {
+	return func(yield func(awstypes.DBInstance, error) bool) {
+		pages := rds.NewDescribeDBInstancesPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.DBInstance{}, fmt.Errorf("listing RDS DB Instances: %w", err))
+				return
+			}
+
+			for _, item := range page.DBInstances {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_db_parameter_group")
+func newParameterGroupResourceAsListResource() inttypes.ListResourceForSDK {
+	l := listResourceParameterGroup{}
+	l.SetResourceSchema(resourceParameterGroup())
+	return &l
+}
+
+var _ list.ListResource = &listResourceParameterGroup{}
+
+type listResourceParameterGroup struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type listParameterGroupModel struct {
+	framework.WithRegionModel
+}
+
+func (l *listResourceParameterGroup) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var query listParameterGroupModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	awsClient := l.Meta()
+	conn := awsClient.RDSClient(ctx)
+
+	tflog.Info(ctx, "Listing RDS DB Parameter Groups")
+	stream.Results = func(yield func(list.ListResult) bool) {
+		var input rds.DescribeDBParameterGroupsInput
+		for item, err := range listDBParameterGroups(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			id := aws.ToString(item.DBParameterGroupName)
+
+			// Default parameter groups are managed by AWS and can't be modified or deleted.
+			if strings.HasPrefix(id, "default.") {
+				continue
+			}
+
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), id)
+
+			result := request.NewListResult(ctx)
+			rd := l.ResourceData()
+			rd.SetId(id)
+			rd.Set(names.AttrName, id)
+
+			tflog.Info(ctx, "Reading RDS DB Parameter Group")
+			diags := resourceParameterGroupRead(ctx, rd, awsClient)
+			if diags.HasError() {
+				tflog.Error(ctx, "Reading RDS DB Parameter Group", map[string]any{
+					names.AttrID: id,
+					"diags":      sdkdiag.DiagnosticsString(diags),
+				})
+				continue
+			}
+			if rd.Id() == "" {
+				// Resource is logically deleted
+				continue
+			}
+
+			result.DisplayName = id
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+func listDBParameterGroups(ctx context.Context, conn *rds.Client, input *rds.DescribeDBParameterGroupsInput) iter.Seq2[awstypes.DBParameterGroup, error] {
+	return func(yield func(awstypes.DBParameterGroup, error) bool) {
+		pages := rds.NewDescribeDBParameterGroupsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.DBParameterGroup{}, fmt.Errorf("listing RDS DB Parameter Groups: %w", err))
+				return
+			}
+
+			for _, item := range page.DBParameterGroups {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,196 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	rebootDBInstanceDelay              = 30 * time.Second
+	rebootDBInstancePollInterval       = 15 * time.Second
+	rebootDBInstanceConsecutiveSuccess = 3
+	rebootDBInstanceDefaultTimeout     = 30 * time.Minute
+)
+
+// @Action(aws_rds_reboot_db_instance, name="Reboot DB Instance")
+func newRebootDBInstanceAction(context.Context) (action.ActionWithConfigure, error) {
+	return &rebootDBInstanceAction{}, nil
+}
+
+var (
+	_ action.Action = (*rebootDBInstanceAction)(nil)
+)
+
+type rebootDBInstanceAction struct {
+	framework.ActionWithModel[rebootDBInstanceActionModel]
+}
+
+type rebootDBInstanceActionModel struct {
+	framework.WithRegionModel
+	DBInstanceIdentifier types.String `tfsdk:"db_instance_identifier"`
+	ForceFailover        types.Bool   `tfsdk:"force_failover"`
+	Timeout              types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *rebootDBInstanceAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reboots an RDS DB instance and waits for it to become available again.",
+		Attributes: map[string]schema.Attribute{
+			"db_instance_identifier": schema.StringAttribute{
+				Description: "Identifier of the DB instance to reboot",
+				Required:    true,
+			},
+			"force_failover": schema.BoolAttribute{
+				Description: "Whether the reboot is conducted through a Multi-AZ failover. The instance must be configured for Multi-AZ",
+				Optional:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the instance to become available (default: 1800)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(7200),
+				},
+			},
+		},
+	}
+}
+
+func (a *rebootDBInstanceAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config rebootDBInstanceActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().RDSClient(ctx)
+
+	id := config.DBInstanceIdentifier.ValueString()
+
+	timeout := rebootDBInstanceDefaultTimeout
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Rebooting RDS DB instance", map[string]any{
+		"db_instance_identifier": id,
+		"force_failover":         config.ForceFailover.ValueBool(),
+		names.AttrTimeout:        timeout.String(),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Rebooting RDS DB instance %s...", id),
+	})
+
+	input := rds.RebootDBInstanceInput{
+		DBInstanceIdentifier: aws.String(id),
+		ForceFailover:        config.ForceFailover.ValueBoolPointer(),
+	}
+	if _, err := conn.RebootDBInstance(ctx, &input); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("rebooting RDS DB Instance (%s)", id), err.Error())
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("RDS DB instance %s reboot started, waiting for it to become available...", id),
+	})
+
+	// The instance may still report available for a short time after the reboot is requested,
+	// so as in waitDBInstanceAvailable polling is delayed and several consecutive successes are required.
+	select {
+	case <-ctx.Done():
+		resp.Diagnostics.AddError(fmt.Sprintf("waiting for RDS DB Instance (%s) reboot", id), ctx.Err().Error())
+		return
+	case <-time.After(rebootDBInstanceDelay):
+	}
+
+	// Instance status is tracked between polls so that each transition is reported exactly once.
+	var lastStatus string
+	fr, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.DBInstance], error) {
+		output, err := findDBInstanceByID(ctx, conn, id)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.DBInstance]{}, err
+		}
+
+		if status := aws.ToString(output.DBInstanceStatus); status != lastStatus {
+			lastStatus = status
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("RDS DB instance %s is %s", id, status),
+			})
+		}
+
+		return actionwait.FetchResult[*awstypes.DBInstance]{Status: actionwait.Status(aws.ToString(output.DBInstanceStatus)), Value: output}, nil
+	}, actionwait.Options[*awstypes.DBInstance]{
+		Timeout:            timeout - rebootDBInstanceDelay,
+		Interval:           actionwait.FixedInterval(rebootDBInstancePollInterval),
+		ConsecutiveSuccess: rebootDBInstanceConsecutiveSuccess,
+		SuccessStates: []actionwait.Status{
+			instanceStatusAvailable,
+			instanceStatusStorageOptimization,
+		},
+		TransitionalStates: []actionwait.Status{
+			instanceStatusBackingUp,
+			instanceStatusConfiguringEnhancedMonitoring,
+			instanceStatusConfiguringIAMDatabaseAuth,
+			instanceStatusConfiguringLogExports,
+			instanceStatusMaintenance,
+			instanceStatusModifying,
+			instanceStatusRebooting,
+			instanceStatusStarting,
+			instanceStatusUpgrading,
+		},
+		FailureStates: []actionwait.Status{
+			instanceStatusFailed,
+			instanceStatusInaccessibleEncryptionCredentials,
+			instanceStatusIncompatibleNetwork,
+			instanceStatusIncompatibleOptionGroup,
+			instanceStatusIncompatibleParameters,
+			instanceStatusStorageFull,
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for DB Instance",
+				fmt.Sprintf("RDS DB Instance (%s) did not become available within %s (last status: %s)", id, timeout, timeoutErr.LastStatus),
+			)
+		} else if errors.As(err, &failureErr) {
+			resp.Diagnostics.AddError(
+				"DB Instance Reboot Failed",
+				fmt.Sprintf("RDS DB Instance (%s) entered status %s after reboot", id, failureErr.Status),
+			)
+		} else {
+			resp.Diagnostics.AddError(fmt.Sprintf("waiting for RDS DB Instance (%s) reboot", id), err.Error())
+		}
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("RDS DB instance %s rebooted and is available", id),
+	})
+
+	tflog.Info(ctx, "RDS DB instance rebooted", map[string]any{
+		"db_instance_identifier": id,
+		"availability_zone":      aws.ToString(fr.Value.AvailabilityZone),
+	})
+}
@@ -0,0 +1,276 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rdsdata
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rdsdata"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rdsdata/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_rdsdata_execute_statement, name="Execute Statement")
+func newExecuteStatementAction(context.Context) (action.ActionWithConfigure, error) {
+	return &executeStatementAction{}, nil
+}
+
+var (
+	_ action.Action = (*executeStatementAction)(nil)
+)
+
+type executeStatementAction struct {
+	framework.ActionWithModel[executeStatementActionModel]
+}
+
+type executeStatementActionModel struct {
+	framework.WithRegionModel
+	Database    types.String                                       `tfsdk:"database"`
+	Parameters  fwtypes.ListNestedObjectValueOf[sqlParameterModel] `tfsdk:"parameter"`
+	ResourceARN fwtypes.ARN                                        `tfsdk:"resource_arn"`
+	Schema      types.String                                       `tfsdk:"schema"`
+	SecretARN   fwtypes.ARN                                        `tfsdk:"secret_arn"`
+	SQL         types.String                                       `tfsdk:"sql"`
+	Statements  fwtypes.ListOfString                               `tfsdk:"statements"`
+}
+
+type sqlParameterModel struct {
+	BooleanValue types.Bool                            `tfsdk:"boolean_value"`
+	DoubleValue  types.Float64                         `tfsdk:"double_value"`
+	LongValue    types.Int64                           `tfsdk:"long_value"`
+	Name         types.String                          `tfsdk:"name"`
+	StringValue  types.String                          `tfsdk:"string_value"`
+	TypeHint     fwtypes.StringEnum[awstypes.TypeHint] `tfsdk:"type_hint"`
+}
+
+func (a *executeStatementAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs SQL statements against an Aurora cluster using the RDS Data API.",
+		Attributes: map[string]schema.Attribute{
+			names.AttrDatabase: schema.StringAttribute{
+				Description: "Name of the database",
+				Optional:    true,
+			},
+			names.AttrResourceARN: schema.StringAttribute{
+				CustomType:  fwtypes.ARNType,
+				Description: "ARN of the Aurora cluster",
+				Required:    true,
+			},
+			names.AttrSchema: schema.StringAttribute{
+				Description: "Name of the database schema",
+				Optional:    true,
+			},
+			"secret_arn": schema.StringAttribute{
+				CustomType:  fwtypes.ARNType,
+				Description: "ARN of the Secrets Manager secret that contains the database credentials",
+				Required:    true,
+			},
+			"sql": schema.StringAttribute{
+				Description: "SQL statement to run",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("sql"), path.MatchRoot("statements")),
+				},
+			},
+			"statements": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				Description: "SQL statements to run, in order, in a single transaction",
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"parameter": schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[sqlParameterModel](ctx),
+				Description: "Parameters for the SQL statement. Only valid with sql",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"boolean_value": schema.BoolAttribute{
+							Description: "Boolean value of the parameter",
+							Optional:    true,
+							Validators: []validator.Bool{
+								boolvalidator.ConflictsWith(
+									path.MatchRelative().AtParent().AtName("double_value"),
+									path.MatchRelative().AtParent().AtName("long_value"),
+								),
+							},
+						},
+						"double_value": schema.Float64Attribute{
+							Description: "Double value of the parameter",
+							Optional:    true,
+							Validators: []validator.Float64{
+								float64validator.ConflictsWith(
+									path.MatchRelative().AtParent().AtName("long_value"),
+								),
+							},
+						},
+						"long_value": schema.Int64Attribute{
+							Description: "Long value of the parameter",
+							Optional:    true,
+						},
+						names.AttrName: schema.StringAttribute{
+							Description: "Name of the parameter, referenced in the SQL statement as :name",
+							Required:    true,
+						},
+						"string_value": schema.StringAttribute{
+							Description: "String value of the parameter. If no value is set the parameter is NULL",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.ConflictsWith(
+									path.MatchRelative().AtParent().AtName("boolean_value"),
+									path.MatchRelative().AtParent().AtName("double_value"),
+									path.MatchRelative().AtParent().AtName("long_value"),
+								),
+							},
+						},
+						"type_hint": schema.StringAttribute{
+							CustomType:  fwtypes.StringEnumType[awstypes.TypeHint](),
+							Description: "Hint that specifies the database type to which string_value is converted",
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *executeStatementAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config executeStatementActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().RDSDataClient(ctx)
+
+	resourceARN := config.ResourceARN.ValueString()
+
+	tflog.Info(ctx, "Executing RDS Data statement", map[string]any{
+		names.AttrResourceARN: resourceARN,
+		names.AttrDatabase:    config.Database.ValueString(),
+	})
+
+	if !config.SQL.IsNull() {
+		parameters, diags := expandSQLParameters(ctx, config.Parameters)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: "Executing SQL statement...",
+		})
+
+		input := rdsdata.ExecuteStatementInput{
+			Database:    config.Database.ValueStringPointer(),
+			Parameters:  parameters,
+			ResourceArn: aws.String(resourceARN),
+			Schema:      config.Schema.ValueStringPointer(),
+			SecretArn:   config.SecretARN.ValueStringPointer(),
+			Sql:         config.SQL.ValueStringPointer(),
+		}
+		output, err := conn.ExecuteStatement(ctx, &input)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("executing RDS Data statement (%s)", resourceARN), err.Error())
+			return
+		}
+
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("SQL statement executed, %d record(s) updated", output.NumberOfRecordsUpdated),
+		})
+
+		tflog.Info(ctx, "RDS Data statement executed", map[string]any{
+			names.AttrResourceARN:       resourceARN,
+			"number_of_records_updated": output.NumberOfRecordsUpdated,
+		})
+		return
+	}
+
+	// Absent blocks are empty lists rather than null, so the conflict can't be expressed as a schema validator.
+	if len(config.Parameters.Elements()) > 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("parameter"), "Invalid Attribute Combination", `"parameter" can only be specified with "sql"`)
+		return
+	}
+
+	statements := fwflex.ExpandFrameworkStringValueList(ctx, config.Statements)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Beginning transaction for %d SQL statement(s)...", len(statements)),
+	})
+
+	transactionID, err := beginTransaction(ctx, conn, resourceARN, config.SecretARN.ValueString(), config.Database.ValueStringPointer(), config.Schema.ValueStringPointer())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("beginning RDS Data transaction (%s)", resourceARN), err.Error())
+		return
+	}
+
+	for i, sql := range statements {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Executing SQL statement %d of %d...", i+1, len(statements)),
+		})
+
+		input := rdsdata.ExecuteStatementInput{
+			Database:      config.Database.ValueStringPointer(),
+			ResourceArn:   aws.String(resourceARN),
+			Schema:        config.Schema.ValueStringPointer(),
+			SecretArn:     config.SecretARN.ValueStringPointer(),
+			Sql:           aws.String(sql),
+			TransactionId: aws.String(transactionID),
+		}
+		output, err := conn.ExecuteStatement(ctx, &input)
+		if err != nil {
+			detail := fmt.Sprintf("statement %d of %d: %s", i+1, len(statements), err)
+			if err := rollbackTransaction(ctx, conn, resourceARN, config.SecretARN.ValueString(), transactionID); err != nil {
+				detail += fmt.Sprintf("\n\nrolling back transaction %s: %s", transactionID, err)
+			} else {
+				detail += fmt.Sprintf("\n\nTransaction %s was rolled back.", transactionID)
+			}
+			resp.Diagnostics.AddError(fmt.Sprintf("executing RDS Data statement (%s)", resourceARN), detail)
+			return
+		}
+
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("SQL statement %d of %d executed, %d record(s) updated", i+1, len(statements), output.NumberOfRecordsUpdated),
+		})
+	}
+
+	input := rdsdata.CommitTransactionInput{
+		ResourceArn:   aws.String(resourceARN),
+		SecretArn:     config.SecretARN.ValueStringPointer(),
+		TransactionId: aws.String(transactionID),
+	}
+	output, err := conn.CommitTransaction(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("committing RDS Data transaction (%s)", transactionID), err.Error())
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Transaction %s committed: %s", transactionID, aws.ToString(output.TransactionStatus)),
+	})
+
+	tflog.Info(ctx, "RDS Data transaction committed", map[string]any{
+		names.AttrResourceARN: resourceARN,
+		"transaction_id":      transactionID,
+		"statements":          len(statements),
+	})
+}
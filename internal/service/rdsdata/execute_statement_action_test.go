@@ -0,0 +1,268 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rdsdata_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRDSDataExecuteStatementAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	dataSourceName := "data.aws_rdsdata_query.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSDataServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExecuteStatementActionConfig_basic(rName),
+			},
+			{
+				Config: acctest.ConfigCompose(testAccExecuteStatementActionConfig_basic(rName), testAccExecuteStatementActionConfig_query),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "records.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "records.0.id", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "records.0.name", "first"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSDataExecuteStatementAction_statements(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	dataSourceName := "data.aws_rdsdata_query.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSDataServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExecuteStatementActionConfig_statements(rName),
+			},
+			{
+				Config: acctest.ConfigCompose(testAccExecuteStatementActionConfig_statements(rName), testAccExecuteStatementActionConfig_query),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "records.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "records.0.name", "first"),
+					resource.TestCheckResourceAttr(dataSourceName, "records.1.name", "second"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSDataExecuteStatementAction_statementsRollback(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSDataServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccExecuteStatementActionConfig_statementsInvalid(rName),
+				ExpectError: regexache.MustCompile(`(?s)statement 2 of 2.*was rolled back`),
+			},
+		},
+	})
+}
+
+func TestAccRDSDataExecuteStatementAction_sqlAndStatements(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSDataServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccExecuteStatementActionConfig_sqlAndStatements(),
+				ExpectError: regexache.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func testAccExecuteStatementActionConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_rds_cluster" "test" {
+  cluster_identifier          = %[1]q
+  engine                      = "aurora-postgresql"
+  engine_mode                 = "provisioned"
+  database_name               = "test"
+  master_username             = "tfacctest"
+  manage_master_user_password = true
+  enable_http_endpoint        = true
+  skip_final_snapshot         = true
+
+  serverlessv2_scaling_configuration {
+    max_capacity = 1.0
+    min_capacity = 0.5
+  }
+}
+
+resource "aws_rds_cluster_instance" "test" {
+  identifier         = %[1]q
+  cluster_identifier = aws_rds_cluster.test.id
+  instance_class     = "db.serverless"
+  engine             = aws_rds_cluster.test.engine
+  engine_version     = aws_rds_cluster.test.engine_version
+}
+`, rName)
+}
+
+func testAccExecuteStatementActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccExecuteStatementActionConfig_base(rName), `
+action "aws_rdsdata_execute_statement" "create" {
+  config {
+    resource_arn = aws_rds_cluster.test.arn
+    secret_arn   = aws_rds_cluster.test.master_user_secret[0].secret_arn
+    database     = aws_rds_cluster.test.database_name
+    sql          = "CREATE TABLE test AS SELECT CAST(:id AS INTEGER) AS id, CAST(:name AS TEXT) AS name"
+
+    parameter {
+      name       = "id"
+      long_value = 1
+    }
+
+    parameter {
+      name         = "name"
+      string_value = "first"
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_rds_cluster_instance.test.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_rdsdata_execute_statement.create]
+    }
+  }
+}
+`)
+}
+
+func testAccExecuteStatementActionConfig_statements(rName string) string {
+	return acctest.ConfigCompose(testAccExecuteStatementActionConfig_base(rName), `
+action "aws_rdsdata_execute_statement" "create" {
+  config {
+    resource_arn = aws_rds_cluster.test.arn
+    secret_arn   = aws_rds_cluster.test.master_user_secret[0].secret_arn
+    database     = aws_rds_cluster.test.database_name
+
+    statements = [
+      "CREATE TABLE test (id INTEGER PRIMARY KEY, name TEXT NOT NULL)",
+      "INSERT INTO test (id, name) VALUES (1, 'first')",
+      "INSERT INTO test (id, name) VALUES (2, 'second')",
+    ]
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_rds_cluster_instance.test.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_rdsdata_execute_statement.create]
+    }
+  }
+}
+`)
+}
+
+func testAccExecuteStatementActionConfig_statementsInvalid(rName string) string {
+	return acctest.ConfigCompose(testAccExecuteStatementActionConfig_base(rName), `
+action "aws_rdsdata_execute_statement" "create" {
+  config {
+    resource_arn = aws_rds_cluster.test.arn
+    secret_arn   = aws_rds_cluster.test.master_user_secret[0].secret_arn
+    database     = aws_rds_cluster.test.database_name
+
+    statements = [
+      "CREATE TABLE test (id INTEGER PRIMARY KEY)",
+      "INSERT INTO does_not_exist (id) VALUES (1)",
+    ]
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_rds_cluster_instance.test.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_rdsdata_execute_statement.create]
+    }
+  }
+}
+`)
+}
+
+func testAccExecuteStatementActionConfig_sqlAndStatements() string {
+	return `
+data "aws_partition" "current" {}
+data "aws_region" "current" {}
+data "aws_caller_identity" "current" {}
+
+action "aws_rdsdata_execute_statement" "test" {
+  config {
+    resource_arn = "arn:${data.aws_partition.current.partition}:rds:${data.aws_region.current.region}:${data.aws_caller_identity.current.account_id}:cluster:test"
+    secret_arn   = "arn:${data.aws_partition.current.partition}:secretsmanager:${data.aws_region.current.region}:${data.aws_caller_identity.current.account_id}:secret:test"
+    sql          = "SELECT 1"
+    statements   = ["SELECT 1"]
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_rdsdata_execute_statement.test]
+    }
+  }
+}
+`
+}
+
+const testAccExecuteStatementActionConfig_query = `
+data "aws_rdsdata_query" "test" {
+  resource_arn = aws_rds_cluster.test.arn
+  secret_arn   = aws_rds_cluster.test.master_user_secret[0].secret_arn
+  database     = aws_rds_cluster.test.database_name
+  sql          = "SELECT id, name FROM test ORDER BY id"
+
+  depends_on = [terraform_data.trigger]
+}
+`
@@ -0,0 +1,225 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rdsdata
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rdsdata"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rdsdata/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_rdsdata_query", name="Query")
+func newQueryDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &queryDataSource{}, nil
+}
+
+type queryDataSource struct {
+	framework.DataSourceWithModel[queryDataSourceModel]
+}
+
+func (d *queryDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrDatabase: schema.StringAttribute{
+				Optional: true,
+			},
+			"records": schema.ListAttribute{
+				ElementType: types.MapType{ElemType: types.StringType},
+				Computed:    true,
+			},
+			"records_json": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrResourceARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			names.AttrSchema: schema.StringAttribute{
+				Optional: true,
+			},
+			"secret_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			"sql": schema.StringAttribute{
+				Required: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"parameter": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[sqlParameterModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"boolean_value": schema.BoolAttribute{
+							Optional: true,
+							Validators: []validator.Bool{
+								boolvalidator.ConflictsWith(
+									path.MatchRelative().AtParent().AtName("double_value"),
+									path.MatchRelative().AtParent().AtName("long_value"),
+								),
+							},
+						},
+						"double_value": schema.Float64Attribute{
+							Optional: true,
+							Validators: []validator.Float64{
+								float64validator.ConflictsWith(
+									path.MatchRelative().AtParent().AtName("long_value"),
+								),
+							},
+						},
+						"long_value": schema.Int64Attribute{
+							Optional: true,
+						},
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+						"string_value": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.ConflictsWith(
+									path.MatchRelative().AtParent().AtName("boolean_value"),
+									path.MatchRelative().AtParent().AtName("double_value"),
+									path.MatchRelative().AtParent().AtName("long_value"),
+								),
+							},
+						},
+						"type_hint": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.TypeHint](),
+							Optional:   true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *queryDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data queryDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().RDSDataClient(ctx)
+
+	parameters, diags := expandSQLParameters(ctx, data.Parameters)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	resourceARN, secretARN := data.ResourceARN.ValueString(), data.SecretARN.ValueString()
+
+	// The statement runs in a transaction that is always rolled back. This undoes most changes, but not implicitly committed
+	// statements such as MySQL DDL or non-transactional side effects such as PostgreSQL sequence increments.
+	transactionID, err := beginTransaction(ctx, conn, resourceARN, secretARN, data.Database.ValueStringPointer(), data.Schema.ValueStringPointer())
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("beginning RDS Data transaction (%s)", resourceARN), err.Error())
+		return
+	}
+
+	input := rdsdata.ExecuteStatementInput{
+		Database:        data.Database.ValueStringPointer(),
+		FormatRecordsAs: awstypes.RecordsFormatTypeJson,
+		Parameters:      parameters,
+		ResourceArn:     aws.String(resourceARN),
+		Schema:          data.Schema.ValueStringPointer(),
+		SecretArn:       aws.String(secretARN),
+		Sql:             data.SQL.ValueStringPointer(),
+		TransactionId:   aws.String(transactionID),
+	}
+	output, err := conn.ExecuteStatement(ctx, &input)
+
+	if err := rollbackTransaction(ctx, conn, resourceARN, secretARN, transactionID); err != nil {
+		response.Diagnostics.AddWarning(
+			fmt.Sprintf("rolling back RDS Data transaction (%s)", transactionID),
+			fmt.Sprintf("%s\n\nThe transaction is rolled back automatically after 3 minutes of inactivity.", err),
+		)
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading RDS Data query (%s)", resourceARN), err.Error())
+		return
+	}
+
+	recordsJSON := aws.ToString(output.FormattedRecords)
+	if recordsJSON == "" {
+		recordsJSON = "[]"
+	}
+
+	records, err := flattenFormattedRecords(recordsJSON)
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading RDS Data query (%s)", resourceARN), err.Error())
+		return
+	}
+
+	data.Records, diags = types.ListValueFrom(ctx, types.MapType{ElemType: types.StringType}, records)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.RecordsJSON = types.StringValue(recordsJSON)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// flattenFormattedRecords converts the JSON-formatted records returned by the Data API to a list of maps of strings.
+// String values are returned as-is, SQL NULLs as null and all other values (numbers, booleans and arrays) as their JSON text.
+func flattenFormattedRecords(s string) ([]map[string]types.String, error) {
+	var apiObjects []map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(s), &apiObjects); err != nil {
+		return nil, fmt.Errorf("decoding formatted records: %w", err)
+	}
+
+	tfList := make([]map[string]types.String, 0, len(apiObjects))
+	for _, apiObject := range apiObjects {
+		tfMap := make(map[string]types.String, len(apiObject))
+		for k, v := range apiObject {
+			switch {
+			case bytes.Equal(v, []byte("null")):
+				tfMap[k] = types.StringNull()
+			case bytes.HasPrefix(v, []byte(`"`)):
+				var str string
+				if err := json.Unmarshal(v, &str); err != nil {
+					return nil, fmt.Errorf("decoding formatted records column (%s): %w", k, err)
+				}
+				tfMap[k] = types.StringValue(str)
+			default:
+				tfMap[k] = types.StringValue(string(v))
+			}
+		}
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList, nil
+}
+
+type queryDataSourceModel struct {
+	framework.WithRegionModel
+	Database    types.String                                       `tfsdk:"database"`
+	Parameters  fwtypes.ListNestedObjectValueOf[sqlParameterModel] `tfsdk:"parameter"`
+	Records     types.List                                         `tfsdk:"records"`
+	RecordsJSON types.String                                       `tfsdk:"records_json"`
+	ResourceARN fwtypes.ARN                                        `tfsdk:"resource_arn"`
+	Schema      types.String                                       `tfsdk:"schema"`
+	SecretARN   fwtypes.ARN                                        `tfsdk:"secret_arn"`
+	SQL         types.String                                       `tfsdk:"sql"`
+}
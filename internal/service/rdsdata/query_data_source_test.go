@@ -0,0 +1,109 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rdsdata_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRDSDataQueryDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	dataSourceName := "data.aws_rdsdata_query.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSDataServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueryDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "records.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "records.0.number", "42"),
+					resource.TestCheckResourceAttr(dataSourceName, "records.0.text", "hello"),
+					resource.TestCheckResourceAttr(dataSourceName, "records.0.flag", acctest.CtTrue),
+					resource.TestCheckNoResourceAttr(dataSourceName, "records.0.nothing"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "records_json"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSDataQueryDataSource_readOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	dataSourceName := "data.aws_rdsdata_query.check"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSDataServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueryDataSourceConfig_readOnly(rName),
+				Check: resource.ComposeTestCheckFunc(
+					// The table created by the first query is rolled back.
+					resource.TestCheckResourceAttr(dataSourceName, "records.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "records.0.count", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccQueryDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccExecuteStatementActionConfig_base(rName), `
+data "aws_rdsdata_query" "test" {
+  resource_arn = aws_rds_cluster.test.arn
+  secret_arn   = aws_rds_cluster.test.master_user_secret[0].secret_arn
+  database     = aws_rds_cluster.test.database_name
+  sql          = "SELECT CAST(:number AS INTEGER) AS number, CAST(:text AS TEXT) AS text, CAST(:flag AS BOOLEAN) AS flag, NULL AS nothing"
+
+  parameter {
+    name       = "number"
+    long_value = 42
+  }
+
+  parameter {
+    name         = "text"
+    string_value = "hello"
+  }
+
+  parameter {
+    name          = "flag"
+    boolean_value = true
+  }
+
+  depends_on = [aws_rds_cluster_instance.test]
+}
+`)
+}
+
+func testAccQueryDataSourceConfig_readOnly(rName string) string {
+	return acctest.ConfigCompose(testAccExecuteStatementActionConfig_base(rName), `
+data "aws_rdsdata_query" "test" {
+  resource_arn = aws_rds_cluster.test.arn
+  secret_arn   = aws_rds_cluster.test.master_user_secret[0].secret_arn
+  database     = aws_rds_cluster.test.database_name
+  sql          = "CREATE TABLE test AS SELECT 1 AS id"
+
+  depends_on = [aws_rds_cluster_instance.test]
+}
+
+data "aws_rdsdata_query" "check" {
+  resource_arn = aws_rds_cluster.test.arn
+  secret_arn   = aws_rds_cluster.test.master_user_secret[0].secret_arn
+  database     = aws_rds_cluster.test.database_name
+  sql          = "SELECT COUNT(*) AS count FROM information_schema.tables WHERE table_name = 'test'"
+
+  depends_on = [data.aws_rdsdata_query.test]
+}
+`)
+}
@@ -0,0 +1,78 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rdsdata
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rdsdata"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rdsdata/types"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+// expandSQLParameters converts the configured parameter blocks to Data API SQL parameters.
+// The Field union type can't be expanded by AutoFlex. A parameter with no value set is NULL.
+func expandSQLParameters(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[sqlParameterModel]) ([]awstypes.SqlParameter, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data, d := tfList.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	apiObjects := make([]awstypes.SqlParameter, 0, len(data))
+	for _, v := range data {
+		apiObject := awstypes.SqlParameter{
+			Name:     v.Name.ValueStringPointer(),
+			TypeHint: v.TypeHint.ValueEnum(),
+		}
+
+		switch {
+		case !v.BooleanValue.IsNull():
+			apiObject.Value = &awstypes.FieldMemberBooleanValue{Value: v.BooleanValue.ValueBool()}
+		case !v.DoubleValue.IsNull():
+			apiObject.Value = &awstypes.FieldMemberDoubleValue{Value: v.DoubleValue.ValueFloat64()}
+		case !v.LongValue.IsNull():
+			apiObject.Value = &awstypes.FieldMemberLongValue{Value: v.LongValue.ValueInt64()}
+		case !v.StringValue.IsNull():
+			apiObject.Value = &awstypes.FieldMemberStringValue{Value: v.StringValue.ValueString()}
+		default:
+			apiObject.Value = &awstypes.FieldMemberIsNull{Value: true}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects, diags
+}
+
+func beginTransaction(ctx context.Context, conn *rdsdata.Client, resourceARN, secretARN string, database, schema *string) (string, error) {
+	input := rdsdata.BeginTransactionInput{
+		Database:    database,
+		ResourceArn: aws.String(resourceARN),
+		Schema:      schema,
+		SecretArn:   aws.String(secretARN),
+	}
+	output, err := conn.BeginTransaction(ctx, &input)
+
+	if err != nil {
+		return "", err
+	}
+
+	return aws.ToString(output.TransactionId), nil
+}
+
+func rollbackTransaction(ctx context.Context, conn *rdsdata.Client, resourceARN, secretARN, transactionID string) error {
+	input := rdsdata.RollbackTransactionInput{
+		ResourceArn:   aws.String(resourceARN),
+		SecretArn:     aws.String(secretARN),
+		TransactionId: aws.String(transactionID),
+	}
+	_, err := conn.RollbackTransaction(ctx, &input)
+
+	return err
+}
@@ -0,0 +1,119 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package redshift
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/redshift"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource(aws_redshift_cluster_credentials, name="Cluster Credentials")
+func newClusterCredentialsEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &clusterCredentialsEphemeralResource{}, nil
+}
+
+type clusterCredentialsEphemeralResource struct {
+	framework.EphemeralResourceWithModel[clusterCredentialsEphemeralResourceModel]
+}
+
+func (e *clusterCredentialsEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"auto_create": schema.BoolAttribute{
+				Optional: true,
+			},
+			names.AttrClusterIdentifier: schema.StringAttribute{
+				Required: true,
+			},
+			"db_groups": schema.SetAttribute{
+				CustomType: fwtypes.SetOfStringType,
+				Optional:   true,
+			},
+			"db_name": schema.StringAttribute{
+				Optional: true,
+			},
+			"db_password": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"db_user": schema.StringAttribute{
+				Required: true,
+			},
+			"db_user_name": schema.StringAttribute{
+				Computed: true,
+			},
+			"duration_seconds": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(900, 3600),
+				},
+			},
+			"expiration": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (e *clusterCredentialsEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	conn := e.Meta().RedshiftClient(ctx)
+	data := clusterCredentialsEphemeralResourceModel{}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	clusterID := data.ClusterIdentifier.ValueString()
+	input := redshift.GetClusterCredentialsInput{
+		AutoCreate:        data.AutoCreate.ValueBoolPointer(),
+		ClusterIdentifier: aws.String(clusterID),
+		DbName:            data.DBName.ValueStringPointer(),
+		DbUser:            data.DBUser.ValueStringPointer(),
+	}
+	if !data.DBGroups.IsNull() {
+		input.DbGroups = fwflex.ExpandFrameworkStringValueSet(ctx, data.DBGroups)
+	}
+	if !data.DurationSeconds.IsNull() {
+		input.DurationSeconds = aws.Int32(int32(data.DurationSeconds.ValueInt64()))
+	}
+
+	out, err := conn.GetClusterCredentials(ctx, &input)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, clusterID)
+		return
+	}
+
+	data.DBPassword = types.StringPointerValue(out.DbPassword)
+	data.DBUserName = types.StringPointerValue(out.DbUser)
+	data.Expiration = types.StringValue(aws.ToTime(out.Expiration).Format(time.RFC3339))
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.Result.Set(ctx, &data))
+}
+
+type clusterCredentialsEphemeralResourceModel struct {
+	framework.WithRegionModel
+	AutoCreate        types.Bool          `tfsdk:"auto_create"`
+	ClusterIdentifier types.String        `tfsdk:"cluster_identifier"`
+	DBGroups          fwtypes.SetOfString `tfsdk:"db_groups"`
+	DBName            types.String        `tfsdk:"db_name"`
+	DBPassword        types.String        `tfsdk:"db_password"`
+	DBUser            types.String        `tfsdk:"db_user"`
+	DBUserName        types.String        `tfsdk:"db_user_name"`
+	DurationSeconds   types.Int64         `tfsdk:"duration_seconds"`
+	Expiration        types.String        `tfsdk:"expiration"`
+}
@@ -0,0 +1,98 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package redshiftserverless
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/redshiftserverless"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+)
+
+// @EphemeralResource(aws_redshiftserverless_credentials, name="Credentials")
+func newCredentialsEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &credentialsEphemeralResource{}, nil
+}
+
+type credentialsEphemeralResource struct {
+	framework.EphemeralResourceWithModel[credentialsEphemeralResourceModel]
+}
+
+func (e *credentialsEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"db_name": schema.StringAttribute{
+				Optional: true,
+			},
+			"db_password": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"db_user": schema.StringAttribute{
+				Computed: true,
+			},
+			"duration_seconds": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(900, 3600),
+				},
+			},
+			"expiration": schema.StringAttribute{
+				Computed: true,
+			},
+			"workgroup_name": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (e *credentialsEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	conn := e.Meta().RedshiftServerlessClient(ctx)
+	data := credentialsEphemeralResourceModel{}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	workgroupName := data.WorkgroupName.ValueString()
+	input := redshiftserverless.GetCredentialsInput{
+		DbName:        data.DBName.ValueStringPointer(),
+		WorkgroupName: aws.String(workgroupName),
+	}
+	if !data.DurationSeconds.IsNull() {
+		input.DurationSeconds = aws.Int32(int32(data.DurationSeconds.ValueInt64()))
+	}
+
+	out, err := conn.GetCredentials(ctx, &input)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, workgroupName)
+		return
+	}
+
+	data.DBPassword = types.StringPointerValue(out.DbPassword)
+	data.DBUser = types.StringPointerValue(out.DbUser)
+	data.Expiration = types.StringValue(aws.ToTime(out.Expiration).Format(time.RFC3339))
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.Result.Set(ctx, &data))
+}
+
+type credentialsEphemeralResourceModel struct {
+	framework.WithRegionModel
+	DBName          types.String `tfsdk:"db_name"`
+	DBPassword      types.String `tfsdk:"db_password"`
+	DBUser          types.String `tfsdk:"db_user"`
+	DurationSeconds types.Int64  `tfsdk:"duration_seconds"`
+	Expiration      types.String `tfsdk:"expiration"`
+	WorkgroupName   types.String `tfsdk:"workgroup_name"`
+}
@@ -0,0 +1,28 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_vpc" "test" {
+  cidr_block           = "10.0.0.0/16"
+  enable_dns_hostnames = true
+  enable_dns_support   = true
+
+  tags = {
+    Name = var.rName
+  }
+}
+
+resource "aws_route53_zone" "test" {
+  count = 2
+
+  name = "${var.rName}-${count.index}.com"
+
+  vpc {
+    vpc_id = aws_vpc.test.id
+  }
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
@@ -34,6 +34,13 @@ import (
 
 // @SDKResource("aws_route53_zone", name="Hosted Zone")
 // @Tags(identifierAttribute="zone_id", resourceType="hostedzone")
+// @IdentityAttribute("zone_id")
+// @Testing(name="Zone")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/route53;route53.GetHostedZoneOutput")
+// @Testing(importIgnore="force_destroy")
+// @Testing(idAttrDuplicates="zone_id")
+// @Testing(preIdentityVersion="v6.30.0")
+// @Testing(existsTakesT=false, destroyTakesT=false)
 func resourceZone() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceZoneCreate,
@@ -41,10 +48,6 @@ func resourceZone() *schema.Resource {
 		UpdateWithoutTimeout: resourceZoneUpdate,
 		DeleteWithoutTimeout: resourceZoneDelete,
 
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -0,0 +1,191 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Function annotations are used for list resource registration to the Provider. DO NOT EDIT.
+// @SDKListResource("aws_route53_zone")
+func newZoneResourceAsListResource() inttypes.ListResourceForSDK {
+	l := listResourceZone{}
+	l.SetResourceSchema(resourceZone())
+	return &l
+}
+
+var _ list.ListResource = &listResourceZone{}
+
+type listResourceZone struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+func (l *listResourceZone) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"private_zone": listschema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to list only private (true) or only public (false) hosted zones",
+			},
+			names.AttrVPCID: listschema.StringAttribute{
+				Optional:    true,
+				Description: "ID of a VPC to list associated private hosted zones for",
+			},
+			"vpc_region": listschema.StringAttribute{
+				Optional:    true,
+				Description: "Region of the VPC specified by vpc_id. Defaults to the provider region",
+			},
+		},
+	}
+}
+
+func (l *listResourceZone) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	awsClient := l.Meta()
+	conn := awsClient.Route53Client(ctx)
+
+	var query listZoneModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	var zones iter.Seq2[awstypes.HostedZone, error]
+	if vpcID := query.VPCID.ValueString(); vpcID != "" {
+		vpcRegion := query.VPCRegion.ValueString()
+		if vpcRegion == "" {
+			vpcRegion = awsClient.Region(ctx)
+		}
+		input := route53.ListHostedZonesByVPCInput{
+			VPCId:     aws.String(vpcID),
+			VPCRegion: awstypes.VPCRegion(vpcRegion),
+		}
+		zones = listZonesByVPC(ctx, conn, &input)
+	} else {
+		var input route53.ListHostedZonesInput
+		zones = listZones(ctx, conn, &input)
+	}
+
+	tflog.Info(ctx, "Listing Route 53 Hosted Zones")
+	stream.Results = func(yield func(list.ListResult) bool) {
+		for item, err := range zones {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			if !query.PrivateZone.IsNull() && (item.Config != nil && item.Config.PrivateZone) != query.PrivateZone.ValueBool() {
+				continue
+			}
+
+			zoneID := cleanZoneID(aws.ToString(item.Id))
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), zoneID)
+
+			result := request.NewListResult(ctx)
+			rd := l.ResourceData()
+			rd.SetId(zoneID)
+
+			tflog.Info(ctx, "Reading Route 53 Hosted Zone")
+			diags := resourceZoneRead(ctx, rd, awsClient)
+			if diags.HasError() {
+				tflog.Error(ctx, "Reading Route 53 Hosted Zone", map[string]any{
+					names.AttrID: zoneID,
+					"diags":      sdkdiag.DiagnosticsString(diags),
+				})
+				continue
+			}
+			if rd.Id() == "" {
+				// Resource is logically deleted
+				continue
+			}
+
+			result.DisplayName = fmt.Sprintf("%s (%s)", normalizeDomainName(aws.ToString(item.Name)), zoneID)
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+			if result.Diagnostics.HasError() {
+				tflog.Error(ctx, "Setting Route 53 Hosted Zone result", map[string]any{
+					names.AttrID: zoneID,
+					"diags":      result.Diagnostics,
+				})
+				continue
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+type listZoneModel struct {
+	PrivateZone types.Bool   `tfsdk:"private_zone"`
+	VPCID       types.String `tfsdk:"vpc_id"`
+	VPCRegion   types.String `tfsdk:"vpc_region"`
+}
+
+func listZones(ctx context.Context, conn *route53.Client, input *route53.ListHostedZonesInput) iter.Seq2[awstypes.HostedZone, error] {
+	return func(yield func(awstypes.HostedZone, error) bool) {
+		pages := route53.NewListHostedZonesPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.HostedZone{}, fmt.Errorf("listing Route 53 Hosted Zone resources: %w", err))
+				return
+			}
+
+			for _, item := range page.HostedZones {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// listZonesByVPC yields the private hosted zones associated with a VPC.
+// Only the fields needed for listing are populated in each hosted zone.
+func listZonesByVPC(ctx context.Context, conn *route53.Client, input *route53.ListHostedZonesByVPCInput) iter.Seq2[awstypes.HostedZone, error] {
+	return func(yield func(awstypes.HostedZone, error) bool) {
+		var stopped bool
+		err := listHostedZonesByVPCPages(ctx, conn, input, func(page *route53.ListHostedZonesByVPCOutput, lastPage bool) bool {
+			for _, item := range page.HostedZoneSummaries {
+				zone := awstypes.HostedZone{
+					Config: &awstypes.HostedZoneConfig{
+						PrivateZone: true,
+					},
+					Id:   item.HostedZoneId,
+					Name: item.Name,
+				}
+				if !yield(zone, nil) {
+					stopped = true
+					return false
+				}
+			}
+
+			return !lastPage
+		})
+
+		if err != nil && !stopped {
+			yield(awstypes.HostedZone{}, fmt.Errorf("listing Route 53 Hosted Zone resources: %w", err))
+		}
+	}
+}
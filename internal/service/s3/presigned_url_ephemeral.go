@@ -0,0 +1,244 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	presignedURLDefaultExpiresIn = 900
+	presignedURLMaxExpiresIn     = 604800 // 7 days.
+)
+
+// @EphemeralResource(aws_s3_presigned_url, name="Presigned URL")
+func newPresignedURLEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &presignedURLEphemeralResource{}, nil
+}
+
+type presignedURLEphemeralResource struct {
+	framework.EphemeralResourceWithModel[presignedURLEphemeralResourceModel]
+}
+
+func (e *presignedURLEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrBucket: schema.StringAttribute{
+				Required: true,
+			},
+			"checksum_algorithm": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ChecksumAlgorithm](),
+				Optional:   true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("checksum_value")),
+				},
+			},
+			"checksum_value": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("checksum_algorithm")),
+				},
+			},
+			names.AttrContentType: schema.StringAttribute{
+				Optional: true,
+			},
+			"expiration": schema.StringAttribute{
+				Computed: true,
+			},
+			"expires_in": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, presignedURLMaxExpiresIn),
+				},
+			},
+			names.AttrKey: schema.StringAttribute{
+				Required: true,
+			},
+			"method": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(http.MethodGet, http.MethodPut),
+				},
+			},
+			"signed_headers": schema.MapAttribute{
+				CustomType: fwtypes.MapOfStringType,
+				Computed:   true,
+			},
+			names.AttrURL: schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"version_id": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (e *presignedURLEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	conn := e.Meta().S3Client(ctx)
+	data := presignedURLEphemeralResourceModel{}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Method.IsNull() {
+		data.Method = types.StringValue(http.MethodGet)
+	}
+	if data.ExpiresIn.IsNull() {
+		data.ExpiresIn = types.Int64Value(presignedURLDefaultExpiresIn)
+	}
+
+	method := data.Method.ValueString()
+	switch method {
+	case http.MethodGet:
+		if !data.ChecksumAlgorithm.IsNull() {
+			response.Diagnostics.AddAttributeError(path.Root("checksum_algorithm"), "Invalid Attribute Combination", `"checksum_algorithm" can only be specified when "method" is "PUT"`)
+		}
+		if !data.ContentType.IsNull() {
+			response.Diagnostics.AddAttributeError(path.Root(names.AttrContentType), "Invalid Attribute Combination", `"content_type" can only be specified when "method" is "PUT"`)
+		}
+	case http.MethodPut:
+		if !data.VersionID.IsNull() {
+			response.Diagnostics.AddAttributeError(path.Root("version_id"), "Invalid Attribute Combination", `"version_id" can only be specified when "method" is "GET"`)
+		}
+	}
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	bucket, key := data.Bucket.ValueString(), data.Key.ValueString()
+	expiresIn := time.Duration(data.ExpiresIn.ValueInt64()) * time.Second
+	presignClient := s3.NewPresignClient(conn, s3.WithPresignExpires(expiresIn))
+
+	signingTime := time.Now()
+	var presigned *v4.PresignedHTTPRequest
+	var err error
+	switch method {
+	case http.MethodPut:
+		input := s3.PutObjectInput{
+			Bucket:      aws.String(bucket),
+			ContentType: data.ContentType.ValueStringPointer(),
+			Key:         aws.String(key),
+		}
+		if !data.ChecksumAlgorithm.IsNull() {
+			algorithm, value := data.ChecksumAlgorithm.ValueEnum(), data.ChecksumValue.ValueStringPointer()
+			input.ChecksumAlgorithm = algorithm
+			switch algorithm {
+			case awstypes.ChecksumAlgorithmCrc32:
+				input.ChecksumCRC32 = value
+			case awstypes.ChecksumAlgorithmCrc32c:
+				input.ChecksumCRC32C = value
+			case awstypes.ChecksumAlgorithmCrc64nvme:
+				input.ChecksumCRC64NVME = value
+			case awstypes.ChecksumAlgorithmSha1:
+				input.ChecksumSHA1 = value
+			case awstypes.ChecksumAlgorithmSha256:
+				input.ChecksumSHA256 = value
+			}
+		}
+
+		var optFns []func(*s3.PresignOptions)
+		if v := data.ContentType.ValueString(); v != "" {
+			// The presigner drops the Content-Type header from requests without a body. Restore it so that it is signed.
+			optFns = append(optFns, func(o *s3.PresignOptions) {
+				o.ClientOptions = append(o.ClientOptions, func(o *s3.Options) {
+					o.APIOptions = append(o.APIOptions, addContentTypeHeaderSetterMiddleware(v))
+				})
+			})
+		}
+
+		presigned, err = presignClient.PresignPutObject(ctx, &input, optFns...)
+	default:
+		input := s3.GetObjectInput{
+			Bucket:    aws.String(bucket),
+			Key:       aws.String(key),
+			VersionId: data.VersionID.ValueStringPointer(),
+		}
+
+		presigned, err = presignClient.PresignGetObject(ctx, &input)
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, bucket+"/"+key)
+		return
+	}
+
+	signedHeaders := make(map[string]attr.Value, len(presigned.SignedHeader))
+	for k := range presigned.SignedHeader {
+		signedHeaders[k] = types.StringValue(presigned.SignedHeader.Get(k))
+	}
+
+	data.Expiration = types.StringValue(signingTime.Add(expiresIn).UTC().Format(time.RFC3339))
+	data.SignedHeaders = fwtypes.NewMapValueOfMust[types.String](ctx, signedHeaders)
+	data.URL = types.StringValue(presigned.URL)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.Result.Set(ctx, &data))
+}
+
+type presignedURLEphemeralResourceModel struct {
+	framework.WithRegionModel
+	Bucket            types.String                                   `tfsdk:"bucket"`
+	ChecksumAlgorithm fwtypes.StringEnum[awstypes.ChecksumAlgorithm] `tfsdk:"checksum_algorithm"`
+	ChecksumValue     types.String                                   `tfsdk:"checksum_value"`
+	ContentType       types.String                                   `tfsdk:"content_type"`
+	Expiration        types.String                                   `tfsdk:"expiration"`
+	ExpiresIn         types.Int64                                    `tfsdk:"expires_in"`
+	Key               types.String                                   `tfsdk:"key"`
+	Method            types.String                                   `tfsdk:"method"`
+	SignedHeaders     fwtypes.MapOfString                            `tfsdk:"signed_headers"`
+	URL               types.String                                   `tfsdk:"url"`
+	VersionID         types.String                                   `tfsdk:"version_id"`
+}
+
+func addContentTypeHeaderSetterMiddleware(contentType string) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		return stack.Build.Add(
+			setContentTypeHeaderMiddleware(contentType),
+			middleware.After,
+		)
+	}
+}
+
+func setContentTypeHeaderMiddleware(contentType string) middleware.BuildMiddleware {
+	return middleware.BuildMiddlewareFunc(
+		"SetPresignedContentType",
+		func(ctx context.Context, in middleware.BuildInput, next middleware.BuildHandler) (out middleware.BuildOutput, metadata middleware.Metadata, err error) {
+			switch req := in.Request.(type) {
+			case *smithyhttp.Request:
+				req.Header.Set("Content-Type", contentType)
+			default:
+				return out, metadata, fmt.Errorf("unknown transport type %T", in.Request)
+			}
+
+			return next.HandleBuild(ctx, in)
+		},
+	)
+}
@@ -0,0 +1,151 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3PresignedURLEphemeral_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.S3ServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             testAccCheckBucketDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPresignedURLEphemeralConfig_basic(rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey(names.AttrURL), knownvalue.StringRegexp(regexache.MustCompile(`^https://.+/test-key\?.*X-Amz-Expires=900&.*X-Amz-Signature=`))),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("method"), knownvalue.StringExact("GET")),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("expires_in"), knownvalue.Int64Exact(900)),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("expiration"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("signed_headers"), knownvalue.MapPartial(map[string]knownvalue.Check{
+						"Host": knownvalue.NotNull(),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func TestAccS3PresignedURLEphemeral_put(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.S3ServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             testAccCheckBucketDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPresignedURLEphemeralConfig_put(rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey(names.AttrURL), knownvalue.StringRegexp(regexache.MustCompile(`^https://.+/test-key\?.*X-Amz-Checksum-Sha256=n4bQgYhMfWWaL%2BqgxVrQFaO%2FTxsrC4Is0V1sFbDwCgg%3D&.*X-Amz-Expires=3600&.*X-Amz-SignedHeaders=content-type%3Bhost&.*X-Amz-Signature=`))),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("method"), knownvalue.StringExact("PUT")),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("signed_headers"), knownvalue.MapPartial(map[string]knownvalue.Check{
+						"Content-Type": knownvalue.StringExact("text/plain"),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func TestAccS3PresignedURLEphemeral_invalidGet(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.S3ServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPresignedURLEphemeralConfig_invalidGet(rName),
+				ExpectError: regexache.MustCompile(`"content_type" can only be specified when "method" is "PUT"`),
+			},
+		},
+	})
+}
+
+func testAccPresignedURLEphemeralConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+`, rName)
+}
+
+func testAccPresignedURLEphemeralConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigWithEchoProvider("ephemeral.aws_s3_presigned_url.test"),
+		testAccPresignedURLEphemeralConfig_base(rName),
+		`
+ephemeral "aws_s3_presigned_url" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  key    = "test-key"
+}
+`)
+}
+
+func testAccPresignedURLEphemeralConfig_put(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigWithEchoProvider("ephemeral.aws_s3_presigned_url.test"),
+		testAccPresignedURLEphemeralConfig_base(rName),
+		`
+ephemeral "aws_s3_presigned_url" "test" {
+  bucket             = aws_s3_bucket.test.bucket
+  key                = "test-key"
+  method             = "PUT"
+  expires_in         = 3600
+  content_type       = "text/plain"
+  checksum_algorithm = "SHA256"
+  checksum_value     = "n4bQgYhMfWWaL+qgxVrQFaO/TxsrC4Is0V1sFbDwCgg="
+}
+`)
+}
+
+func testAccPresignedURLEphemeralConfig_invalidGet(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigWithEchoProvider("ephemeral.aws_s3_presigned_url.test"),
+		fmt.Sprintf(`
+ephemeral "aws_s3_presigned_url" "test" {
+  bucket       = %[1]q
+  key          = "test-key"
+  content_type = "text/plain"
+}
+`, rName))
+}
@@ -16,4 +16,5 @@ var (
 	FindSecretVersionByTwoPartKey      = findSecretVersionByTwoPartKey
 	FindSecretVersionEntryByTwoPartKey = findSecretVersionEntryByTwoPartKey
 	FindSecretTag                      = findSecretTag
+	RotationStatus                     = rotationStatus
 )
@@ -0,0 +1,235 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	rotateSecretPollInterval   = 10 * time.Second
+	rotateSecretDefaultTimeout = 15 * time.Minute
+)
+
+// Statuses reported while waiting for a rotation to complete.
+// Secrets Manager doesn't report rotation failures, so the status is derived from the staging labels:
+// a rotation has failed once the new version loses AWSPENDING without becoming AWSCURRENT,
+// or once AWSPENDING is attached to a different version.
+const (
+	rotationStatusCreating   actionwait.Status = "CREATING"
+	rotationStatusPending    actionwait.Status = secretVersionStagePending
+	rotationStatusCurrent    actionwait.Status = secretVersionStageCurrent
+	rotationStatusCancelled  actionwait.Status = "CANCELLED"
+	rotationStatusSuperseded actionwait.Status = "SUPERSEDED"
+)
+
+// @Action(aws_secretsmanager_rotate_secret, name="Rotate Secret")
+func newRotateSecretAction(context.Context) (action.ActionWithConfigure, error) {
+	return &rotateSecretAction{}, nil
+}
+
+var (
+	_ action.Action = (*rotateSecretAction)(nil)
+)
+
+type rotateSecretAction struct {
+	framework.ActionWithModel[rotateSecretActionModel]
+}
+
+type rotateSecretActionModel struct {
+	framework.WithRegionModel
+	SecretID types.String `tfsdk:"secret_id"`
+	Timeout  types.Int64  `tfsdk:"timeout"`
+}
+
+func (a *rotateSecretAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Rotates a Secrets Manager secret immediately and waits for the new version to become AWSCURRENT.",
+		Attributes: map[string]schema.Attribute{
+			"secret_id": schema.StringAttribute{
+				Description: "ARN or name of the secret to rotate",
+				Required:    true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the rotation to complete (default: 900)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(7200),
+				},
+			},
+		},
+	}
+}
+
+func (a *rotateSecretAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config rotateSecretActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().SecretsManagerClient(ctx)
+
+	secretID := config.SecretID.ValueString()
+
+	timeout := rotateSecretDefaultTimeout
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	secret, err := findSecretByID(ctx, conn, secretID)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("reading Secrets Manager Secret (%s)", secretID), err.Error())
+		return
+	}
+
+	if !aws.ToBool(secret.RotationEnabled) {
+		resp.Diagnostics.AddError(
+			"Rotation Not Configured",
+			fmt.Sprintf("Secrets Manager Secret (%s) does not have rotation enabled. Configure rotation with the aws_secretsmanager_secret_rotation resource.", secretID),
+		)
+		return
+	}
+
+	secretARN := aws.ToString(secret.ARN)
+
+	tflog.Info(ctx, "Starting Secrets Manager secret rotation", map[string]any{
+		"secret_arn":      secretARN,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Starting rotation of secret %s...", secretARN),
+	})
+
+	input := secretsmanager.RotateSecretInput{
+		RotateImmediately: aws.Bool(true),
+		SecretId:          aws.String(secretARN),
+	}
+	output, err := conn.RotateSecret(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("rotating Secrets Manager Secret (%s)", secretARN), err.Error())
+		return
+	}
+
+	versionID := aws.ToString(output.VersionId)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Rotation started, waiting for version %s to become %s...", versionID, secretVersionStageCurrent),
+	})
+
+	// Rotation status is tracked between polls so that each transition is reported exactly once.
+	var lastStatus actionwait.Status
+	_, err = actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[struct{}], error) {
+		output, err := findSecretByID(ctx, conn, secretARN)
+		if err != nil {
+			return actionwait.FetchResult[struct{}]{}, err
+		}
+
+		status := rotationStatus(output.VersionIdsToStages, versionID, lastStatus)
+
+		if status != lastStatus {
+			lastStatus = status
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Secret version %s is %s", versionID, status),
+			})
+		}
+
+		return actionwait.FetchResult[struct{}]{Status: status}, nil
+	}, actionwait.Options[struct{}]{
+		Timeout:  timeout,
+		Interval: actionwait.FixedInterval(rotateSecretPollInterval),
+		SuccessStates: []actionwait.Status{
+			rotationStatusCurrent,
+		},
+		TransitionalStates: []actionwait.Status{
+			rotationStatusCreating,
+			rotationStatusPending,
+		},
+		FailureStates: []actionwait.Status{
+			rotationStatusCancelled,
+			rotationStatusSuperseded,
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Rotation",
+				fmt.Sprintf("Secrets Manager Secret (%s) version %s did not become %s within %s (last status: %s)", secretARN, versionID, secretVersionStageCurrent, timeout, timeoutErr.LastStatus),
+			)
+		} else if errors.As(err, &failureErr) && failureErr.Status == rotationStatusSuperseded {
+			resp.Diagnostics.AddError(
+				"Rotation Failed",
+				fmt.Sprintf("Secrets Manager Secret (%s) version %s was superseded by another rotation before becoming %s", secretARN, versionID, secretVersionStageCurrent),
+			)
+		} else if errors.As(err, &failureErr) {
+			resp.Diagnostics.AddError(
+				"Rotation Failed",
+				fmt.Sprintf("Secrets Manager Secret (%s) version %s lost the %s staging label without becoming %s. Check the logs of rotation function %s for errors.", secretARN, versionID, secretVersionStagePending, secretVersionStageCurrent, aws.ToString(secret.RotationLambdaARN)),
+			)
+		} else {
+			resp.Diagnostics.AddError(fmt.Sprintf("waiting for Secrets Manager Secret (%s) rotation", secretARN), err.Error())
+		}
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Secret %s rotated, version %s is %s", secretARN, versionID, secretVersionStageCurrent),
+	})
+
+	tflog.Info(ctx, "Secrets Manager secret rotated", map[string]any{
+		"secret_arn": secretARN,
+		"version_id": versionID,
+	})
+}
+
+// rotationStatus returns the status of the rotation that creates versionID from the secret's staging labels.
+// lastStatus is the status returned by the previous poll.
+func rotationStatus(versionIDsToStages map[string][]string, versionID string, lastStatus actionwait.Status) actionwait.Status {
+	stages, ok := versionIDsToStages[versionID]
+
+	switch {
+	case slices.Contains(stages, secretVersionStageCurrent):
+		// Secrets Manager removes AWSPENDING once AWSCURRENT has been moved to the new version.
+		if slices.Contains(stages, secretVersionStagePending) {
+			return rotationStatusPending
+		}
+		return rotationStatusCurrent
+	case slices.Contains(stages, secretVersionStagePending):
+		return rotationStatusPending
+	}
+
+	for k, v := range versionIDsToStages {
+		if k != versionID && slices.Contains(v, secretVersionStagePending) {
+			return rotationStatusSuperseded
+		}
+	}
+
+	// The new version doesn't exist until the rotation function's createSecret step has run,
+	// and it's no longer listed once its last staging label has been removed.
+	if ok || lastStatus == rotationStatusPending {
+		return rotationStatusCancelled
+	}
+
+	return rotationStatusCreating
+}
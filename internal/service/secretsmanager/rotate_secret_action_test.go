@@ -0,0 +1,305 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package secretsmanager_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	tfsecretsmanager "github.com/hashicorp/terraform-provider-aws/internal/service/secretsmanager"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestRotationStatus(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		versionIDsToStages map[string][]string
+		lastStatus         actionwait.Status
+		expected           actionwait.Status
+	}{
+		"not created": {
+			versionIDsToStages: map[string][]string{
+				"v1": {"AWSCURRENT"},
+			},
+			expected: "CREATING",
+		},
+		"pending": {
+			versionIDsToStages: map[string][]string{
+				"v1": {"AWSCURRENT"},
+				"v2": {"AWSPENDING"},
+			},
+			lastStatus: "CREATING",
+			expected:   "AWSPENDING",
+		},
+		"current and pending": {
+			versionIDsToStages: map[string][]string{
+				"v1": {"AWSPREVIOUS"},
+				"v2": {"AWSCURRENT", "AWSPENDING"},
+			},
+			lastStatus: "AWSPENDING",
+			expected:   "AWSPENDING",
+		},
+		"current": {
+			versionIDsToStages: map[string][]string{
+				"v1": {"AWSPREVIOUS"},
+				"v2": {"AWSCURRENT"},
+			},
+			lastStatus: "AWSPENDING",
+			expected:   "AWSCURRENT",
+		},
+		"labels removed": {
+			versionIDsToStages: map[string][]string{
+				"v1": {"AWSCURRENT"},
+			},
+			lastStatus: "AWSPENDING",
+			expected:   "CANCELLED",
+		},
+		"custom label only": {
+			versionIDsToStages: map[string][]string{
+				"v1": {"AWSCURRENT"},
+				"v2": {"custom"},
+			},
+			lastStatus: "AWSPENDING",
+			expected:   "CANCELLED",
+		},
+		"superseded": {
+			versionIDsToStages: map[string][]string{
+				"v1": {"AWSCURRENT"},
+				"v3": {"AWSPENDING"},
+			},
+			lastStatus: "AWSPENDING",
+			expected:   "SUPERSEDED",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfsecretsmanager.RotationStatus(testCase.versionIDsToStages, "v2", testCase.lastStatus)
+
+			if got != testCase.expected {
+				t.Errorf("got %s, expected %s", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestAccSecretsManagerRotateSecretAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecretsManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRotateSecretActionConfig_basic(rName, "rotation.handler"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecretRotated(ctx, t, "aws_secretsmanager_secret.test", "aws_secretsmanager_secret_version.test"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSecretsManagerRotateSecretAction_failed(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecretsManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRotateSecretActionConfig_basic(rName, "rotation.failing_handler"),
+				ExpectError: regexache.MustCompile(`(?s)Rotation Failed.*lost the AWSPENDING staging label`),
+			},
+		},
+	})
+}
+
+func TestAccSecretsManagerRotateSecretAction_rotationNotConfigured(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecretsManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRotateSecretActionConfig_rotationNotConfigured(rName),
+				ExpectError: regexache.MustCompile(`Rotation Not Configured`),
+			},
+		},
+	})
+}
+
+// testAccCheckSecretRotated verifies that the secret's AWSCURRENT version is no longer the version created by Terraform.
+func testAccCheckSecretRotated(ctx context.Context, t *testing.T, secretName, versionName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[secretName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", secretName)
+		}
+
+		vs, ok := s.RootModule().Resources[versionName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", versionName)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).SecretsManagerClient(ctx)
+
+		output, err := tfsecretsmanager.FindSecretByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		for versionID, stages := range output.VersionIdsToStages {
+			if slices.Contains(stages, "AWSCURRENT") {
+				if versionID == vs.Primary.Attributes["version_id"] {
+					return fmt.Errorf("Secrets Manager Secret (%s) was not rotated", rs.Primary.ID)
+				}
+
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Secrets Manager Secret (%s) has no AWSCURRENT version", rs.Primary.ID)
+	}
+}
+
+func testAccRotateSecretActionConfig_basic(rName, handler string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLambdaBase(rName, rName, rName),
+		fmt.Sprintf(`
+resource "aws_iam_role_policy" "rotation" {
+  name = "%[1]s-rotation"
+  role = aws_iam_role.iam_for_lambda.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Action = [
+        "secretsmanager:DescribeSecret",
+        "secretsmanager:GetSecretValue",
+        "secretsmanager:PutSecretValue",
+        "secretsmanager:UpdateSecretVersionStage",
+      ]
+      Resource = aws_secretsmanager_secret.test.arn
+    }]
+  })
+}
+
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/rotation.zip"
+  function_name = %[1]q
+  handler       = %[2]q
+  role          = aws_iam_role.iam_for_lambda.arn
+  runtime       = "python3.12"
+  timeout       = 30
+}
+
+resource "aws_lambda_permission" "test" {
+  action        = "lambda:InvokeFunction"
+  function_name = aws_lambda_function.test.function_name
+  principal     = "secretsmanager.amazonaws.com"
+  statement_id  = "AllowExecutionFromSecretsManager"
+}
+
+resource "aws_secretsmanager_secret" "test" {
+  name = %[1]q
+}
+
+resource "aws_secretsmanager_secret_version" "test" {
+  secret_id     = aws_secretsmanager_secret.test.id
+  secret_string = "test-string"
+}
+
+resource "aws_secretsmanager_secret_rotation" "test" {
+  secret_id           = aws_secretsmanager_secret.test.id
+  rotation_lambda_arn = aws_lambda_function.test.arn
+  rotate_immediately  = false
+
+  rotation_rules {
+    automatically_after_days = 7
+  }
+
+  depends_on = [aws_lambda_permission.test, aws_secretsmanager_secret_version.test]
+}
+
+action "aws_secretsmanager_rotate_secret" "test" {
+  config {
+    secret_id = aws_secretsmanager_secret.test.arn
+    timeout   = 300
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_secretsmanager_secret_rotation.test.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_secretsmanager_rotate_secret.test]
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.rotation, aws_iam_role_policy.iam_policy_for_lambda]
+}
+`, rName, handler))
+}
+
+func testAccRotateSecretActionConfig_rotationNotConfigured(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test" {
+  name = %[1]q
+}
+
+resource "aws_secretsmanager_secret_version" "test" {
+  secret_id     = aws_secretsmanager_secret.test.id
+  secret_string = "test-string"
+}
+
+action "aws_secretsmanager_rotate_secret" "test" {
+  config {
+    secret_id = aws_secretsmanager_secret.test.arn
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_secretsmanager_secret_version.test.version_id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_secretsmanager_rotate_secret.test]
+    }
+  }
+}
+`, rName)
+}
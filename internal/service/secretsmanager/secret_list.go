@@ -0,0 +1,143 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	"github.com/hashicorp/terraform-provider-aws/internal/namevaluesfilters"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_secretsmanager_secret")
+func secretResourceAsListResource() inttypes.ListResourceForSDK {
+	l := secretListResource{}
+	l.SetResourceSchema(resourceSecret())
+	return &l
+}
+
+type secretListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type secretListResourceModel struct {
+	framework.WithRegionModel
+	Name     types.String `tfsdk:"name"`
+	TagKey   types.String `tfsdk:"tag_key"`
+	TagValue types.String `tfsdk:"tag_value"`
+}
+
+func (l *secretListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			names.AttrName: listschema.StringAttribute{
+				Optional:    true,
+				Description: "Limits the results to secrets whose name begins with the specified value.",
+			},
+			"tag_key": listschema.StringAttribute{
+				Optional:    true,
+				Description: "Limits the results to secrets with a tag key that begins with the specified value.",
+			},
+			"tag_value": listschema.StringAttribute{
+				Optional:    true,
+				Description: "Limits the results to secrets with a tag value that begins with the specified value.",
+			},
+		},
+	}
+}
+
+func (l *secretListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var query secretListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	awsClient := l.Meta()
+	conn := awsClient.SecretsManagerClient(ctx)
+
+	tflog.Info(ctx, "Listing Secrets Manager secrets")
+	stream.Results = func(yield func(list.ListResult) bool) {
+		input := secretsmanager.ListSecretsInput{
+			Filters: namevaluesfilters.New(map[string]string{
+				string(awstypes.FilterNameStringTypeName):     query.Name.ValueString(),
+				string(awstypes.FilterNameStringTypeTagKey):   query.TagKey.ValueString(),
+				string(awstypes.FilterNameStringTypeTagValue): query.TagValue.ValueString(),
+			}).SecretsManagerFilters(),
+		}
+		for item, err := range listSecrets(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			secretARN := aws.ToString(item.ARN)
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), secretARN)
+
+			result := request.NewListResult(ctx)
+			rd := l.ResourceData()
+			rd.SetId(secretARN)
+
+			// Only secret metadata is read. Secret values are never retrieved.
+			diags := resourceSecretRead(ctx, rd, awsClient)
+			if diags.HasError() || rd.Id() == "" {
+				// Resource can't be read or is logically deleted.
+				// Log and continue.
+				tflog.Error(ctx, "Reading Secrets Manager secret", map[string]any{
+					names.AttrID: secretARN,
+					"diags":      sdkdiag.DiagnosticsString(diags),
+				})
+				continue
+			}
+
+			result.DisplayName = aws.ToString(item.Name)
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+func listSecrets(ctx context.Context, conn *secretsmanager.Client, input *secretsmanager.ListSecretsInput) iter.Seq2[awstypes.SecretListEntry, error] {
+	return func(yield func(awstypes.SecretListEntry, error) bool) {
+		pages := secretsmanager.NewListSecretsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.SecretListEntry{}, fmt.Errorf("listing Secrets Manager Secrets: %w", err))
+				return
+			}
+
+			for _, secret := range page.SecretList {
+				if !yield(secret, nil) {
+					return
+				}
+			}
+		}
+	}
+}
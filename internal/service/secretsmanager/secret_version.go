@@ -32,6 +32,7 @@ import (
 
 const (
 	secretVersionStageCurrent  = "AWSCURRENT"
+	secretVersionStagePending  = "AWSPENDING"
 	secretVersionStagePrevious = "AWSPREVIOUS"
 )
 
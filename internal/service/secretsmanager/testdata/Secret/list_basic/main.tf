@@ -0,0 +1,15 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_secretsmanager_secret" "test" {
+  count = 2
+
+  name                    = "${var.rName}-${count.index}"
+  recovery_window_in_days = 0
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
@@ -12,4 +12,6 @@ var (
 	FindActivityByARN     = findActivityByARN
 	FindAliasByARN        = findAliasByARN
 	FindStateMachineByARN = findStateMachineByARN
+
+	TruncateExecutionOutput = truncateExecutionOutput
 )
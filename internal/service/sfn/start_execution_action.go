@@ -5,20 +5,40 @@ package sfn
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/sfn"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sfn/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/action/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+const (
+	startExecutionPollInterval   = 10 * time.Second
+	startExecutionDefaultTimeout = 60 * time.Minute
+
+	// startExecutionMaxOutputLength limits the size of the execution output included in progress messages.
+	startExecutionMaxOutputLength = 1024
+)
+
 // @Action(aws_sfn_start_execution, name="Start Execution")
 func newStartExecutionAction(_ context.Context) (action.ActionWithConfigure, error) {
 	return &startExecutionAction{}, nil
@@ -34,10 +54,12 @@ type startExecutionAction struct {
 
 type startExecutionActionModel struct {
 	framework.WithRegionModel
-	StateMachineArn types.String `tfsdk:"state_machine_arn"`
-	Input           types.String `tfsdk:"input"`
-	Name            types.String `tfsdk:"name"`
-	TraceHeader     types.String `tfsdk:"trace_header"`
+	StateMachineArn   types.String `tfsdk:"state_machine_arn"`
+	Input             types.String `tfsdk:"input"`
+	Name              types.String `tfsdk:"name"`
+	Timeout           types.Int64  `tfsdk:"timeout"`
+	TraceHeader       types.String `tfsdk:"trace_header"`
+	WaitForCompletion types.Bool   `tfsdk:"wait_for_completion"`
 }
 
 func (a *startExecutionAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
@@ -59,10 +81,22 @@ func (a *startExecutionAction) Schema(ctx context.Context, req action.SchemaRequ
 				Description: "Name of the execution. Must be unique within the account/region/state machine for 90 days. Auto-generated if not provided.",
 				Optional:    true,
 			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the execution to complete when wait_for_completion is true. Defaults to 3600.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(10),
+					int64validator.AtMost(86400),
+				},
+			},
 			"trace_header": schema.StringAttribute{
 				Description: "AWS X-Ray trace header for distributed tracing.",
 				Optional:    true,
 			},
+			"wait_for_completion": schema.BoolAttribute{
+				Description: "Whether to wait for the execution to complete and fail if it does not succeed. Express state machines are run synchronously. Defaults to false.",
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -88,6 +122,7 @@ func (a *startExecutionAction) Invoke(ctx context.Context, req action.InvokeRequ
 		"input_length":      len(input),
 		"has_name":          !config.Name.IsNull(),
 		"has_trace_header":  !config.TraceHeader.IsNull(),
+		"wait":              config.WaitForCompletion.ValueBool(),
 	})
 
 	resp.SendProgress(action.InvokeProgressEvent{
@@ -107,6 +142,27 @@ func (a *startExecutionAction) Invoke(ctx context.Context, req action.InvokeRequ
 		startInput.TraceHeader = config.TraceHeader.ValueStringPointer()
 	}
 
+	if config.WaitForCompletion.ValueBool() {
+		timeout := startExecutionDefaultTimeout
+		if !config.Timeout.IsNull() {
+			timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+		}
+
+		// Express state machines don't record execution history, so they are run synchronously instead.
+		stateMachine, err := findStateMachineByARN(ctx, conn, unqualifiedStateMachineARN(stateMachineArn))
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("reading Step Functions State Machine (%s)", stateMachineArn), err.Error())
+			return
+		}
+
+		if stateMachine.Type == awstypes.StateMachineTypeExpress {
+			a.startSyncExecution(ctx, conn, startInput, timeout, resp)
+		} else {
+			a.startExecutionAndWait(ctx, conn, startInput, timeout, resp)
+		}
+		return
+	}
+
 	output, err := conn.StartExecution(ctx, startInput)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -127,3 +183,227 @@ func (a *startExecutionAction) Invoke(ctx context.Context, req action.InvokeRequ
 		"start_date":        output.StartDate,
 	})
 }
+
+// startSyncExecution runs an Express state machine execution and reports its result.
+func (a *startExecutionAction) startSyncExecution(ctx context.Context, conn *sfn.Client, startInput *sfn.StartExecutionInput, timeout time.Duration, resp *action.InvokeResponse) {
+	stateMachineArn := aws.ToString(startInput.StateMachineArn)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	input := sfn.StartSyncExecutionInput{
+		Input:           startInput.Input,
+		Name:            startInput.Name,
+		StateMachineArn: startInput.StateMachineArn,
+		TraceHeader:     startInput.TraceHeader,
+	}
+	output, err := conn.StartSyncExecution(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Start Step Functions Execution",
+			fmt.Sprintf("Could not start synchronous execution for state machine %s: %s", stateMachineArn, err),
+		)
+		return
+	}
+
+	executionArn := aws.ToString(output.ExecutionArn)
+
+	if output.Status != awstypes.SyncExecutionStatusSucceeded {
+		resp.Diagnostics.AddError(
+			"Step Functions Execution Failed",
+			fmt.Sprintf("Execution %s %s: %s", executionArn, output.Status, executionFailureDetail(output.Error, output.Cause)),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Execution %s succeeded with output: %s", executionArn, truncateExecutionOutput(aws.ToString(output.Output))),
+	})
+
+	tflog.Info(ctx, "Step Functions synchronous execution succeeded", map[string]any{
+		"state_machine_arn": stateMachineArn,
+		"execution_arn":     executionArn,
+	})
+}
+
+// startExecutionAndWait starts a Standard state machine execution and waits for it to complete,
+// reporting the states entered by the execution as progress.
+func (a *startExecutionAction) startExecutionAndWait(ctx context.Context, conn *sfn.Client, startInput *sfn.StartExecutionInput, timeout time.Duration, resp *action.InvokeResponse) {
+	stateMachineArn := aws.ToString(startInput.StateMachineArn)
+
+	output, err := conn.StartExecution(ctx, startInput)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Start Step Functions Execution",
+			fmt.Sprintf("Could not start execution for state machine %s: %s", stateMachineArn, err),
+		)
+		return
+	}
+
+	executionArn := aws.ToString(output.ExecutionArn)
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Execution %s started, waiting for completion...", executionArn),
+	})
+
+	// The ID of the last history event reported is tracked between polls so that each state entered is reported exactly once.
+	var lastEventID int64
+	fr, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*sfn.DescribeExecutionOutput], error) {
+		events, err := findExecutionHistoryEventsAfter(ctx, conn, executionArn, lastEventID)
+		if err != nil {
+			return actionwait.FetchResult[*sfn.DescribeExecutionOutput]{}, err
+		}
+		for _, event := range events {
+			lastEventID = event.Id
+			if v := event.StateEnteredEventDetails; v != nil {
+				resp.SendProgress(action.InvokeProgressEvent{
+					Message: fmt.Sprintf("Execution %s entered state %s", executionArn, aws.ToString(v.Name)),
+				})
+			}
+		}
+
+		output, err := findExecutionByARN(ctx, conn, executionArn)
+		if err != nil {
+			return actionwait.FetchResult[*sfn.DescribeExecutionOutput]{}, err
+		}
+
+		return actionwait.FetchResult[*sfn.DescribeExecutionOutput]{Status: actionwait.Status(output.Status), Value: output}, nil
+	}, actionwait.Options[*sfn.DescribeExecutionOutput]{
+		Timeout:  timeout,
+		Interval: actionwait.FixedInterval(startExecutionPollInterval),
+		SuccessStates: []actionwait.Status{
+			actionwait.Status(awstypes.ExecutionStatusSucceeded),
+		},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.ExecutionStatusRunning),
+			actionwait.Status(awstypes.ExecutionStatusPendingRedrive),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.ExecutionStatusFailed),
+			actionwait.Status(awstypes.ExecutionStatusTimedOut),
+			actionwait.Status(awstypes.ExecutionStatusAborted),
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Step Functions Execution",
+				fmt.Sprintf("Execution %s did not complete within %s (last status: %s). The execution has not been stopped.", executionArn, timeout, timeoutErr.LastStatus),
+			)
+		} else if errors.As(err, &failureErr) {
+			resp.Diagnostics.AddError(
+				"Step Functions Execution Failed",
+				fmt.Sprintf("Execution %s %s: %s", executionArn, failureErr.Status, executionFailureDetail(fr.Value.Error, fr.Value.Cause)),
+			)
+		} else {
+			resp.Diagnostics.AddError(fmt.Sprintf("waiting for Step Functions Execution (%s)", executionArn), err.Error())
+		}
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Execution %s succeeded with output: %s", executionArn, truncateExecutionOutput(aws.ToString(fr.Value.Output))),
+	})
+
+	tflog.Info(ctx, "Step Functions execution succeeded", map[string]any{
+		"state_machine_arn": stateMachineArn,
+		"execution_arn":     executionArn,
+		"stop_date":         fr.Value.StopDate,
+	})
+}
+
+func findExecutionByARN(ctx context.Context, conn *sfn.Client, arn string) (*sfn.DescribeExecutionOutput, error) {
+	input := &sfn.DescribeExecutionInput{
+		ExecutionArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeExecution(ctx, input)
+
+	if errs.IsA[*awstypes.ExecutionDoesNotExist](err) {
+		return nil, &sdkretry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError()
+	}
+
+	return output, nil
+}
+
+// findExecutionHistoryEventsAfter returns, oldest first, the execution's history events with IDs greater than afterID.
+func findExecutionHistoryEventsAfter(ctx context.Context, conn *sfn.Client, executionARN string, afterID int64) ([]awstypes.HistoryEvent, error) {
+	input := sfn.GetExecutionHistoryInput{
+		ExecutionArn:         aws.String(executionARN),
+		IncludeExecutionData: aws.Bool(false),
+		ReverseOrder:         true,
+	}
+
+	var events []awstypes.HistoryEvent
+	pages := sfn.NewGetExecutionHistoryPaginator(conn, &input)
+pages:
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, event := range page.Events {
+			if event.Id <= afterID {
+				break pages
+			}
+			events = append(events, event)
+		}
+	}
+
+	slices.Reverse(events)
+
+	return events, nil
+}
+
+// unqualifiedStateMachineARN strips any version or alias qualifier from a state machine ARN.
+func unqualifiedStateMachineARN(stateMachineARN string) string {
+	v, err := arn.Parse(stateMachineARN)
+	if err != nil {
+		return stateMachineARN
+	}
+
+	// Resource is "stateMachine:name", "stateMachine:name:version" or "stateMachine:name:alias".
+	if parts := strings.Split(v.Resource, ":"); len(parts) > 2 {
+		v.Resource = strings.Join(parts[:2], ":")
+	}
+
+	return v.String()
+}
+
+func executionFailureDetail(errorName, cause *string) string {
+	detail := aws.ToString(errorName)
+	if detail == "" {
+		detail = "no error available"
+	}
+	if v := aws.ToString(cause); v != "" {
+		detail = fmt.Sprintf("%s (cause: %s)", detail, v)
+	}
+
+	return detail
+}
+
+func truncateExecutionOutput(output string) string {
+	if len(output) > startExecutionMaxOutputLength {
+		// Back up to the start of a rune so that a multi-byte character isn't split.
+		n := startExecutionMaxOutputLength
+		for n > 0 && !utf8.RuneStart(output[n]) {
+			n--
+		}
+		return output[:n] + "..."
+	}
+
+	return output
+}
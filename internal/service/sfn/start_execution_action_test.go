@@ -6,8 +6,11 @@ package sfn_test
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
+	"unicode/utf8"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/sfn"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/sfn/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -16,9 +19,47 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsfn "github.com/hashicorp/terraform-provider-aws/internal/service/sfn"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestTruncateExecutionOutput(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		output   string
+		expected string
+	}{
+		"short": {
+			output:   `{"result":"ok"}`,
+			expected: `{"result":"ok"}`,
+		},
+		"ASCII": {
+			output:   strings.Repeat("a", 1030),
+			expected: strings.Repeat("a", 1024) + "...",
+		},
+		"multi-byte rune at limit": {
+			output:   strings.Repeat("a", 1023) + "é" + "b",
+			expected: strings.Repeat("a", 1023) + "...",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfsfn.TruncateExecutionOutput(testCase.output)
+
+			if got != testCase.expected {
+				t.Errorf("got %q, expected %q", got, testCase.expected)
+			}
+			if !utf8.ValidString(got) {
+				t.Errorf("got invalid UTF-8 %q", got)
+			}
+		})
+	}
+}
+
 func TestAccSFNStartExecutionAction_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -91,8 +132,119 @@ func TestAccSFNStartExecutionAction_emptyInput(t *testing.T) {
 	})
 }
 
+func TestAccSFNStartExecutionAction_waitForCompletion(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartExecutionActionConfig_waitForCompletion(rName, "STANDARD", "Pass"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStartExecutionActionStatus(ctx, rName, awstypes.ExecutionStatusSucceeded),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSFNStartExecutionAction_waitForCompletionFailed(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStartExecutionActionConfig_waitForCompletion(rName, "STANDARD", "Fail"),
+				ExpectError: regexache.MustCompile(`(?s)Step Functions Execution Failed.*FAILED: TestError \(cause: test failure cause\)`),
+			},
+		},
+	})
+}
+
+func TestAccSFNStartExecutionAction_waitForCompletionExpress(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartExecutionActionConfig_waitForCompletion(rName, "EXPRESS", "Pass"),
+			},
+		},
+	})
+}
+
+func TestAccSFNStartExecutionAction_waitForCompletionExpressFailed(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStartExecutionActionConfig_waitForCompletion(rName, "EXPRESS", "Fail"),
+				ExpectError: regexache.MustCompile(`(?s)Step Functions Execution Failed.*FAILED: TestError \(cause: test failure cause\)`),
+			},
+		},
+	})
+}
+
 // Test helper functions
 
+func testAccCheckStartExecutionActionStatus(ctx context.Context, stateMachineName string, expectedStatus awstypes.ExecutionStatus) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SFNClient(ctx)
+
+		stateMachineArn := s.RootModule().Resources["aws_sfn_state_machine.test"].Primary.Attributes[names.AttrARN]
+
+		executions, err := conn.ListExecutions(ctx, &sfn.ListExecutionsInput{
+			StateMachineArn: &stateMachineArn,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list executions for state machine %s: %w", stateMachineName, err)
+		}
+
+		if len(executions.Executions) == 0 {
+			return fmt.Errorf("no executions found for state machine %s", stateMachineName)
+		}
+
+		// The action waits for completion, so the execution has its final status.
+		if status := executions.Executions[0].Status; status != expectedStatus {
+			return fmt.Errorf("execution status mismatch. Expected: %s, Got: %s", expectedStatus, status)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckStartExecutionAction(ctx context.Context, stateMachineName, expectedInput string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).SFNClient(ctx)
@@ -273,6 +425,84 @@ resource "terraform_data" "trigger" {
 `)
 }
 
+func testAccStartExecutionActionConfig_waitForCompletion(rName, stateMachineType, stateType string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+data "aws_service_principal" "states" {
+  service_name = "states"
+  region       = data.aws_region.current.name
+}
+
+resource "aws_iam_role" "for_sfn" {
+  name = "%[1]s-sfn"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = data.aws_service_principal.states.name
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+
+locals {
+  states = {
+    Pass = {
+      Type   = "Pass"
+      Result = { result = "done" }
+      End    = true
+    }
+    Fail = {
+      Type  = "Fail"
+      Error = "TestError"
+      Cause = "test failure cause"
+    }
+  }
+}
+
+resource "aws_sfn_state_machine" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.for_sfn.arn
+  type     = %[2]q
+
+  definition = jsonencode({
+    StartAt = "Prepare"
+    States = {
+      Prepare = {
+        Type = "Pass"
+        Next = "Finish"
+      }
+      Finish = local.states[%[3]q]
+    }
+  })
+}
+
+action "aws_sfn_start_execution" "test" {
+  config {
+    state_machine_arn   = aws_sfn_state_machine.test.arn
+    wait_for_completion = true
+    timeout             = 300
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "trigger"
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_sfn_start_execution.test]
+    }
+  }
+
+  depends_on = [aws_sfn_state_machine.test]
+}
+`, rName, stateMachineType, stateType)
+}
+
 func testAccStartExecutionActionConfig_base(rName string) string {
 	return fmt.Sprintf(`
 data "aws_region" "current" {}
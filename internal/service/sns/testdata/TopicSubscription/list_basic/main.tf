@@ -0,0 +1,26 @@
+# Copyright IBM Corp. 2014, 2026
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_sns_topic_subscription" "test" {
+  count = 2
+
+  topic_arn = aws_sns_topic.test.arn
+  protocol  = "sqs"
+  endpoint  = aws_sqs_queue.test[count.index].arn
+}
+
+resource "aws_sns_topic" "test" {
+  name = var.rName
+}
+
+resource "aws_sqs_queue" "test" {
+  count = 2
+
+  name = "${var.rName}-${count.index}"
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
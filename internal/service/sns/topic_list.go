@@ -0,0 +1,142 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sns
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sns/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_sns_topic")
+func topicResourceAsListResource() inttypes.ListResourceForSDK {
+	l := topicListResource{}
+	l.SetResourceSchema(resourceTopic())
+	return &l
+}
+
+type topicListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type topicListResourceModel struct {
+	framework.WithRegionModel
+	Tags tftags.Map `tfsdk:"tags"`
+}
+
+func (l *topicListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			names.AttrTags: listschema.MapAttribute{
+				CustomType:  tftags.MapType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Map of tags, each pair of which must exactly match a pair on the desired topics.",
+			},
+		},
+	}
+}
+
+func (l *topicListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var query topicListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	awsClient := l.Meta()
+	conn := awsClient.SNSClient(ctx)
+
+	filterTags := tftags.New(ctx, query.Tags)
+
+	tflog.Info(ctx, "Listing SNS topics")
+	stream.Results = func(yield func(list.ListResult) bool) {
+		var input sns.ListTopicsInput
+		for item, err := range listTopics(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			topicARN := aws.ToString(item.TopicArn)
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), topicARN)
+
+			if len(filterTags) > 0 {
+				tags, err := listTags(ctx, conn, topicARN)
+				if err != nil {
+					result := fwdiag.NewListResultErrorDiagnostic(fmt.Errorf("listing tags for SNS Topic (%s): %w", topicARN, err))
+					yield(result)
+					return
+				}
+				if !tags.ContainsAll(filterTags) {
+					continue
+				}
+			}
+
+			result := request.NewListResult(ctx)
+			rd := l.ResourceData()
+			rd.SetId(topicARN)
+
+			diags := resourceTopicRead(ctx, rd, awsClient)
+			if diags.HasError() || rd.Id() == "" {
+				// Resource can't be read or is logically deleted.
+				// Log and continue.
+				tflog.Error(ctx, "Reading SNS topic", map[string]any{
+					names.AttrID: topicARN,
+					"diags":      sdkdiag.DiagnosticsString(diags),
+				})
+				continue
+			}
+
+			result.DisplayName = rd.Get(names.AttrName).(string)
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+func listTopics(ctx context.Context, conn *sns.Client, input *sns.ListTopicsInput) iter.Seq2[awstypes.Topic, error] {
+	return func(yield func(awstypes.Topic, error) bool) {
+		pages := sns.NewListTopicsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.Topic{}, fmt.Errorf("listing SNS Topics: %w", err))
+				return
+			}
+
+			for _, topic := range page.Topics {
+				if !yield(topic, nil) {
+					return
+				}
+			}
+		}
+	}
+}
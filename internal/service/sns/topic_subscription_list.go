@@ -0,0 +1,133 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sns
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sns/types"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKListResource("aws_sns_topic_subscription")
+func topicSubscriptionResourceAsListResource() inttypes.ListResourceForSDK {
+	l := topicSubscriptionListResource{}
+	l.SetResourceSchema(resourceTopicSubscription())
+	return &l
+}
+
+type topicSubscriptionListResource struct {
+	framework.ListResourceWithSDKv2Resource
+}
+
+type topicSubscriptionListResourceModel struct {
+	framework.WithRegionModel
+	TopicARN types.String `tfsdk:"topic_arn"`
+}
+
+func (l *topicSubscriptionListResource) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			names.AttrTopicARN: listschema.StringAttribute{
+				Required:    true,
+				Description: "ARN of the SNS topic to list subscriptions for.",
+			},
+		},
+	}
+}
+
+func (l *topicSubscriptionListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var query topicSubscriptionListResourceModel
+	if request.Config.Raw.IsKnown() && !request.Config.Raw.IsNull() {
+		if diags := request.Config.Get(ctx, &query); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	awsClient := l.Meta()
+	conn := awsClient.SNSClient(ctx)
+
+	tflog.Info(ctx, "Listing SNS topic subscriptions")
+	stream.Results = func(yield func(list.ListResult) bool) {
+		input := sns.ListSubscriptionsByTopicInput{
+			TopicArn: query.TopicARN.ValueStringPointer(),
+		}
+		for item, err := range listSubscriptionsByTopic(ctx, conn, &input) {
+			if err != nil {
+				result := fwdiag.NewListResultErrorDiagnostic(err)
+				yield(result)
+				return
+			}
+
+			subscriptionARN := aws.ToString(item.SubscriptionArn)
+
+			// Subscriptions awaiting confirmation have no ARN and can't be managed.
+			if subscriptionARN == subscriptionAttributeNamePendingConfirmation {
+				continue
+			}
+
+			ctx := tflog.SetField(ctx, logging.ResourceAttributeKey(names.AttrID), subscriptionARN)
+
+			result := request.NewListResult(ctx)
+			rd := l.ResourceData()
+			rd.SetId(subscriptionARN)
+
+			diags := resourceTopicSubscriptionRead(ctx, rd, awsClient)
+			if diags.HasError() || rd.Id() == "" {
+				// Resource can't be read or is logically deleted.
+				// Log and continue.
+				tflog.Error(ctx, "Reading SNS topic subscription", map[string]any{
+					names.AttrID: subscriptionARN,
+					"diags":      sdkdiag.DiagnosticsString(diags),
+				})
+				continue
+			}
+
+			result.DisplayName = fmt.Sprintf("%s (%s)", aws.ToString(item.Endpoint), aws.ToString(item.Protocol))
+
+			l.SetResult(ctx, awsClient, request.IncludeResource, &result, rd)
+			if result.Diagnostics.HasError() {
+				yield(result)
+				return
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+}
+
+func listSubscriptionsByTopic(ctx context.Context, conn *sns.Client, input *sns.ListSubscriptionsByTopicInput) iter.Seq2[awstypes.Subscription, error] {
+	return func(yield func(awstypes.Subscription, error) bool) {
+		pages := sns.NewListSubscriptionsByTopicPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				yield(awstypes.Subscription{}, fmt.Errorf("listing SNS Topic Subscriptions: %w", err))
+				return
+			}
+
+			for _, subscription := range page.Subscriptions {
+				if !yield(subscription, nil) {
+					return
+				}
+			}
+		}
+	}
+}
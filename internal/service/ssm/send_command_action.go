@@ -0,0 +1,386 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/actionvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	sendCommandPollInterval   = 5 * time.Second
+	sendCommandDefaultTimeout = 30 * time.Minute
+)
+
+// @Action(aws_ssm_send_command, name="Send Command")
+func newSendCommandAction(context.Context) (action.ActionWithConfigure, error) {
+	return &sendCommandAction{}, nil
+}
+
+var (
+	_ action.Action = (*sendCommandAction)(nil)
+)
+
+type sendCommandAction struct {
+	framework.ActionWithModel[sendCommandActionModel]
+}
+
+type sendCommandActionModel struct {
+	framework.WithRegionModel
+	Comment            types.String                                        `tfsdk:"comment"`
+	DocumentName       types.String                                        `tfsdk:"document_name"`
+	DocumentVersion    types.String                                        `tfsdk:"document_version"`
+	InstanceIDs        fwtypes.ListOfString                                `tfsdk:"instance_ids"`
+	MaxConcurrency     types.String                                        `tfsdk:"max_concurrency"`
+	MaxErrors          types.String                                        `tfsdk:"max_errors"`
+	OutputS3BucketName types.String                                        `tfsdk:"output_s3_bucket_name"`
+	OutputS3KeyPrefix  types.String                                        `tfsdk:"output_s3_key_prefix"`
+	Parameters         types.Map                                           `tfsdk:"parameters" autoflex:"-"`
+	Targets            fwtypes.ListNestedObjectValueOf[commandTargetModel] `tfsdk:"targets"`
+	Timeout            types.Int64                                         `tfsdk:"timeout" autoflex:"-"`
+}
+
+type commandTargetModel struct {
+	Key    types.String         `tfsdk:"key"`
+	Values fwtypes.ListOfString `tfsdk:"values"`
+}
+
+func (a *sendCommandAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs an SSM Run Command document on managed nodes and waits for every command invocation to complete.",
+		Attributes: map[string]schema.Attribute{
+			names.AttrComment: schema.StringAttribute{
+				Description: "User-specified information about the command",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(100),
+				},
+			},
+			"document_name": schema.StringAttribute{
+				Description: "Name or ARN of the Command document to run",
+				Required:    true,
+			},
+			"document_version": schema.StringAttribute{
+				Description: "Version of the Command document to run. Defaults to the default version of the document",
+				Optional:    true,
+			},
+			"instance_ids": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				Description: "IDs of the managed nodes on which to run the command. Exactly one of instance_ids or targets must be set",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 50),
+				},
+			},
+			"max_concurrency": schema.StringAttribute{
+				Description: "Maximum number or percentage of managed nodes that run the command at the same time",
+				Optional:    true,
+			},
+			"max_errors": schema.StringAttribute{
+				Description: "Maximum number or percentage of errors allowed before the command stops being sent to additional managed nodes",
+				Optional:    true,
+			},
+			"output_s3_bucket_name": schema.StringAttribute{
+				Description: "Name of the S3 bucket where command execution responses are stored",
+				Optional:    true,
+			},
+			"output_s3_key_prefix": schema.StringAttribute{
+				Description: "Prefix of the S3 keys under which command execution responses are stored",
+				Optional:    true,
+			},
+			names.AttrParameters: schema.MapAttribute{
+				Description: "Map of document parameter names to lists of values",
+				Optional:    true,
+				ElementType: types.ListType{ElemType: types.StringType},
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the command to complete (default: 1800)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(30),
+					int64validator.AtMost(172800),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"targets": schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[commandTargetModel](ctx),
+				Description: "Tag keys, resource group names or instance IDs used to select the managed nodes on which to run the command. Exactly one of instance_ids or targets must be set",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(5),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrKey: schema.StringAttribute{
+							Description: "Target key, for example tag:Environment or InstanceIds",
+							Required:    true,
+						},
+						names.AttrValues: schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							Description: "Target values",
+							Required:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *sendCommandAction) ConfigValidators(_ context.Context) []action.ConfigValidator {
+	return []action.ConfigValidator{
+		actionvalidator.ExactlyOneOf(
+			path.MatchRoot("instance_ids"),
+			path.MatchRoot("targets"),
+		),
+	}
+}
+
+func (a *sendCommandAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config sendCommandActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().SSMClient(ctx)
+
+	documentName := config.DocumentName.ValueString()
+
+	timeout := sendCommandDefaultTimeout
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Sending SSM command", map[string]any{
+		"document_name":   documentName,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Sending command %s...", documentName),
+	})
+
+	var input ssm.SendCommandInput
+	resp.Diagnostics.Append(fwflex.Expand(ctx, config, &input)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parameters, diags := expandActionParameters(ctx, config.Parameters)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input.Parameters = parameters
+
+	output, err := conn.SendCommand(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("sending SSM command %s", documentName), err.Error())
+		return
+	}
+
+	commandID := aws.ToString(output.Command.CommandId)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Command %s sent, waiting for command invocations to complete...", commandID),
+	})
+
+	// Invocation and plugin statuses are tracked between polls so that each transition is reported exactly once.
+	stepStatuses := make(map[string]string)
+	var invocations []awstypes.CommandInvocation
+	_, err = actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.Command], error) {
+		command, err := findCommandByID(ctx, conn, commandID)
+		if tfresource.NotFound(err) {
+			// The command may not be listed immediately after it is sent.
+			return actionwait.FetchResult[*awstypes.Command]{Status: actionwait.Status(awstypes.CommandStatusPending)}, nil
+		}
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.Command]{}, err
+		}
+
+		invocations, err = findCommandInvocationsByCommandID(ctx, conn, commandID)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.Command]{}, err
+		}
+
+		for _, invocation := range invocations {
+			instanceID := aws.ToString(invocation.InstanceId)
+			if status := string(invocation.Status); stepStatuses[instanceID] != status {
+				stepStatuses[instanceID] = status
+				resp.SendProgress(action.InvokeProgressEvent{
+					Message: fmt.Sprintf("Command invocation on %s is %s", instanceID, status),
+				})
+			}
+
+			for _, plugin := range invocation.CommandPlugins {
+				pluginName := aws.ToString(plugin.Name)
+				key := instanceID + "/" + pluginName
+				if status := string(plugin.Status); stepStatuses[key] != status {
+					stepStatuses[key] = status
+					resp.SendProgress(action.InvokeProgressEvent{
+						Message: fmt.Sprintf("Step %s on %s is %s", pluginName, instanceID, status),
+					})
+				}
+			}
+		}
+
+		return actionwait.FetchResult[*awstypes.Command]{Status: actionwait.Status(command.Status), Value: command}, nil
+	}, actionwait.Options[*awstypes.Command]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(sendCommandPollInterval),
+		ProgressInterval: time.Minute,
+		SuccessStates: []actionwait.Status{
+			actionwait.Status(awstypes.CommandStatusSuccess),
+		},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.CommandStatusPending),
+			actionwait.Status(awstypes.CommandStatusInProgress),
+			actionwait.Status(awstypes.CommandStatusCancelling),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.CommandStatusFailed),
+			actionwait.Status(awstypes.CommandStatusTimedOut),
+			actionwait.Status(awstypes.CommandStatusCancelled),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Command %s is currently %s, continuing to wait...", commandID, fr.Status),
+			})
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Command",
+				fmt.Sprintf("SSM command %s (%s) did not complete within %s (last status: %s)", commandID, documentName, timeout, timeoutErr.LastStatus),
+			)
+		} else if errors.As(err, &failureErr) {
+			resp.Diagnostics.AddError(
+				"Command Failed",
+				fmt.Sprintf("SSM command %s (%s) completed with status %s.%s", commandID, documentName, failureErr.Status, commandInvocationsFailureDetail(invocations)),
+			)
+		} else {
+			resp.Diagnostics.AddError(fmt.Sprintf("waiting for SSM command %s", commandID), err.Error())
+		}
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Command %s completed successfully on %d managed node(s)", commandID, len(invocations)),
+	})
+
+	tflog.Info(ctx, "SSM command completed", map[string]any{
+		"document_name": documentName,
+		"command_id":    commandID,
+	})
+}
+
+func findCommandByID(ctx context.Context, conn *ssm.Client, id string) (*awstypes.Command, error) {
+	input := &ssm.ListCommandsInput{
+		CommandId: aws.String(id),
+	}
+
+	output, err := conn.ListCommands(ctx, input)
+
+	if errs.IsA[*awstypes.InvalidCommandId](err) {
+		return nil, &sdkretry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError()
+	}
+
+	return tfresource.AssertSingleValueResult(output.Commands)
+}
+
+func findCommandInvocationsByCommandID(ctx context.Context, conn *ssm.Client, id string) ([]awstypes.CommandInvocation, error) {
+	input := &ssm.ListCommandInvocationsInput{
+		CommandId: aws.String(id),
+		Details:   true,
+	}
+	var output []awstypes.CommandInvocation
+
+	pages := ssm.NewListCommandInvocationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.InvalidCommandId](err) {
+			return nil, &sdkretry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.CommandInvocations...)
+	}
+
+	return output, nil
+}
+
+// commandInvocationsFailureDetail renders the status and the output of each unsuccessful
+// step of every unsuccessful command invocation for use in a diagnostic.
+func commandInvocationsFailureDetail(invocations []awstypes.CommandInvocation) string {
+	var sb strings.Builder
+
+	for _, invocation := range tfslices.Filter(invocations, func(v awstypes.CommandInvocation) bool {
+		return v.Status != awstypes.CommandInvocationStatusSuccess
+	}) {
+		fmt.Fprintf(&sb, "\n\nInstance %s: %s", aws.ToString(invocation.InstanceId), aws.ToString(invocation.StatusDetails))
+
+		for _, plugin := range invocation.CommandPlugins {
+			if plugin.Status == awstypes.CommandPluginStatusSuccess {
+				continue
+			}
+
+			fmt.Fprintf(&sb, "\nStep %s: %s (response code %d)", aws.ToString(plugin.Name), plugin.Status, plugin.ResponseCode)
+			if v := strings.TrimSpace(aws.ToString(plugin.Output)); v != "" {
+				fmt.Fprintf(&sb, "\n%s", v)
+			}
+		}
+	}
+
+	return sb.String()
+}
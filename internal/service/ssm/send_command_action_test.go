@@ -0,0 +1,256 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSMSendCommandAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSendCommandActionConfig_base(rName),
+			},
+			{
+				// Allow the SSM Agent to register the EC2 instance as a managed node.
+				PreConfig: func() { time.Sleep(1 * time.Minute) },
+				Config:    testAccSendCommandActionConfig_basic(rName, "echo hello"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCommandStatus(ctx, t, "aws_instance.test", awstypes.CommandStatusSuccess),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMSendCommandAction_failed(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSendCommandActionConfig_base(rName),
+			},
+			{
+				PreConfig:   func() { time.Sleep(1 * time.Minute) },
+				Config:      testAccSendCommandActionConfig_basic(rName, "echo expected failure && exit 3"),
+				ExpectError: regexache.MustCompile(`(?s)Command Failed.*Step aws:runShellScript: Failed \(response code 3\).*expected failure`),
+			},
+		},
+	})
+}
+
+func TestAccSSMSendCommandAction_instanceIDsAndTargets(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSendCommandActionConfig_instanceIDsAndTargets(),
+				ExpectError: regexache.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+// testAccCheckCommandStatus verifies that the most recent command sent to the instance has the expected status.
+func testAccCheckCommandStatus(ctx context.Context, t *testing.T, n string, want awstypes.CommandStatus) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).SSMClient(ctx)
+
+		input := ssm.ListCommandsInput{
+			InstanceId: aws.String(rs.Primary.ID),
+			MaxResults: aws.Int32(1),
+		}
+		output, err := conn.ListCommands(ctx, &input)
+
+		if err != nil {
+			return err
+		}
+
+		if len(output.Commands) == 0 {
+			return fmt.Errorf("no commands found for %s", rs.Primary.ID)
+		}
+
+		if got := output.Commands[0].Status; got != want {
+			return fmt.Errorf("command status = %s, want %s", got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccSendCommandActionConfig_base(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
+		acctest.AvailableEC2InstanceTypeForRegion("t3.micro", "t2.micro"),
+		acctest.ConfigVPCWithSubnets(rName, 1),
+		fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "ec2.${data.aws_partition.current.dns_suffix}"
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  role       = aws_iam_role.test.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/AmazonSSMManagedInstanceCore"
+}
+
+resource "aws_iam_instance_profile" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.name
+}
+
+resource "aws_internet_gateway" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route_table" "test" {
+  vpc_id = aws_vpc.test.id
+
+  route {
+    cidr_block = "0.0.0.0/0"
+    gateway_id = aws_internet_gateway.test.id
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route_table_association" "test" {
+  subnet_id      = aws_subnet.test[0].id
+  route_table_id = aws_route_table.test.id
+}
+
+resource "aws_instance" "test" {
+  ami                         = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  instance_type               = data.aws_ec2_instance_type_offering.available.instance_type
+  iam_instance_profile        = aws_iam_instance_profile.test.name
+  subnet_id                   = aws_subnet.test[0].id
+  associate_public_ip_address = true
+
+  depends_on = [aws_iam_role_policy_attachment.test, aws_route_table_association.test]
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccSendCommandActionConfig_basic(rName, command string) string {
+	return acctest.ConfigCompose(testAccSendCommandActionConfig_base(rName), fmt.Sprintf(`
+action "aws_ssm_send_command" "test" {
+  config {
+    document_name = "AWS-RunShellScript"
+    instance_ids  = [aws_instance.test.id]
+    comment       = "acceptance test"
+
+    parameters = {
+      commands = [%[1]q]
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_instance.test.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_ssm_send_command.test]
+    }
+  }
+}
+`, command))
+}
+
+func testAccSendCommandActionConfig_instanceIDsAndTargets() string {
+	return `
+action "aws_ssm_send_command" "test" {
+  config {
+    document_name = "AWS-RunShellScript"
+    instance_ids  = ["i-1234567890abcdef0"]
+
+    targets {
+      key    = "tag:Environment"
+      values = ["test"]
+    }
+
+    parameters = {
+      commands = ["echo hello"]
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = "test"
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_ssm_send_command.test]
+    }
+  }
+}
+`
+}
@@ -0,0 +1,331 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	startAutomationExecutionPollInterval   = 10 * time.Second
+	startAutomationExecutionDefaultTimeout = 60 * time.Minute
+)
+
+// @Action(aws_ssm_start_automation_execution, name="Start Automation Execution")
+func newStartAutomationExecutionAction(context.Context) (action.ActionWithConfigure, error) {
+	return &startAutomationExecutionAction{}, nil
+}
+
+var (
+	_ action.Action = (*startAutomationExecutionAction)(nil)
+)
+
+type startAutomationExecutionAction struct {
+	framework.ActionWithModel[startAutomationExecutionActionModel]
+}
+
+type startAutomationExecutionActionModel struct {
+	framework.WithRegionModel
+	DocumentName    types.String `tfsdk:"document_name"`
+	DocumentVersion types.String `tfsdk:"document_version"`
+	Parameters      types.Map    `tfsdk:"parameters" autoflex:"-"`
+	Timeout         types.Int64  `tfsdk:"timeout" autoflex:"-"`
+}
+
+func (a *startAutomationExecutionAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts an SSM Automation runbook execution and waits for it to complete.",
+		Attributes: map[string]schema.Attribute{
+			"document_name": schema.StringAttribute{
+				Description: "Name or ARN of the Automation runbook to run",
+				Required:    true,
+			},
+			"document_version": schema.StringAttribute{
+				Description: "Version of the Automation runbook to run. Defaults to the default version of the runbook",
+				Optional:    true,
+			},
+			names.AttrParameters: schema.MapAttribute{
+				Description: "Map of runbook parameter names to lists of values",
+				Optional:    true,
+				ElementType: types.ListType{ElemType: types.StringType},
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Description: "Timeout in seconds to wait for the execution to complete (default: 3600)",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+					int64validator.AtMost(43200),
+				},
+			},
+		},
+	}
+}
+
+func (a *startAutomationExecutionAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config startAutomationExecutionActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().SSMClient(ctx)
+
+	documentName := config.DocumentName.ValueString()
+
+	timeout := startAutomationExecutionDefaultTimeout
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Second
+	}
+
+	tflog.Info(ctx, "Starting SSM Automation execution", map[string]any{
+		"document_name":   documentName,
+		names.AttrTimeout: timeout.String(),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Starting Automation execution of %s...", documentName),
+	})
+
+	var input ssm.StartAutomationExecutionInput
+	resp.Diagnostics.Append(fwflex.Expand(ctx, config, &input)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parameters, diags := expandActionParameters(ctx, config.Parameters)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input.Parameters = parameters
+
+	output, err := conn.StartAutomationExecution(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("starting SSM Automation execution of %s", documentName), err.Error())
+		return
+	}
+
+	executionID := aws.ToString(output.AutomationExecutionId)
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Automation execution %s started, waiting for completion...", executionID),
+	})
+
+	// Step statuses are tracked between polls so that each step transition is reported exactly once.
+	stepStatuses := make(map[string]awstypes.AutomationExecutionStatus)
+	fr, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.AutomationExecution], error) {
+		execution, err := findAutomationExecutionByID(ctx, conn, executionID)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.AutomationExecution]{}, err
+		}
+
+		for _, step := range execution.StepExecutions {
+			id, status := aws.ToString(step.StepExecutionId), step.StepStatus
+			if previous, ok := stepStatuses[id]; ok && previous == status {
+				continue
+			}
+			stepStatuses[id] = status
+
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Step %s (%s) is %s", aws.ToString(step.StepName), aws.ToString(step.Action), status),
+			})
+		}
+
+		return actionwait.FetchResult[*awstypes.AutomationExecution]{Status: actionwait.Status(execution.AutomationExecutionStatus), Value: execution}, nil
+	}, actionwait.Options[*awstypes.AutomationExecution]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(startAutomationExecutionPollInterval),
+		ProgressInterval: 2 * time.Minute,
+		SuccessStates: []actionwait.Status{
+			actionwait.Status(awstypes.AutomationExecutionStatusSuccess),
+			actionwait.Status(awstypes.AutomationExecutionStatusCompletedWithSuccess),
+		},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.AutomationExecutionStatusPending),
+			actionwait.Status(awstypes.AutomationExecutionStatusInprogress),
+			actionwait.Status(awstypes.AutomationExecutionStatusWaiting),
+			actionwait.Status(awstypes.AutomationExecutionStatusCancelling),
+			actionwait.Status(awstypes.AutomationExecutionStatusPendingApproval),
+			actionwait.Status(awstypes.AutomationExecutionStatusApproved),
+			actionwait.Status(awstypes.AutomationExecutionStatusScheduled),
+			actionwait.Status(awstypes.AutomationExecutionStatusRunbookInprogress),
+			actionwait.Status(awstypes.AutomationExecutionStatusPendingChangeCalendarOverride),
+			actionwait.Status(awstypes.AutomationExecutionStatusChangeCalendarOverrideApproved),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.AutomationExecutionStatusFailed),
+			actionwait.Status(awstypes.AutomationExecutionStatusTimedout),
+			actionwait.Status(awstypes.AutomationExecutionStatusCancelled),
+			actionwait.Status(awstypes.AutomationExecutionStatusRejected),
+			actionwait.Status(awstypes.AutomationExecutionStatusChangeCalendarOverrideRejected),
+			actionwait.Status(awstypes.AutomationExecutionStatusCompletedWithFailure),
+			actionwait.Status(awstypes.AutomationExecutionStatusExited),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			resp.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("Automation execution %s is currently %s, continuing to wait...", executionID, fr.Status),
+			})
+		},
+	})
+	if err != nil {
+		var timeoutErr *actionwait.TimeoutError
+		var failureErr *actionwait.FailureStateError
+		if errors.As(err, &timeoutErr) {
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Automation Execution",
+				fmt.Sprintf("SSM Automation execution %s of %s did not complete within %s (last status: %s)", executionID, documentName, timeout, timeoutErr.LastStatus),
+			)
+		} else if errors.As(err, &failureErr) {
+			resp.Diagnostics.AddError(
+				"Automation Execution Failed",
+				fmt.Sprintf("SSM Automation execution %s of %s completed with status %s.%s", executionID, documentName, failureErr.Status, automationExecutionFailureDetail(fr.Value)),
+			)
+		} else {
+			resp.Diagnostics.AddError(fmt.Sprintf("waiting for SSM Automation execution %s", executionID), err.Error())
+		}
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Automation execution %s completed successfully", executionID),
+	})
+
+	tflog.Info(ctx, "SSM Automation execution completed", map[string]any{
+		"document_name":           documentName,
+		"automation_execution_id": executionID,
+	})
+}
+
+func findAutomationExecutionByID(ctx context.Context, conn *ssm.Client, id string) (*awstypes.AutomationExecution, error) {
+	input := &ssm.GetAutomationExecutionInput{
+		AutomationExecutionId: aws.String(id),
+	}
+
+	output, err := conn.GetAutomationExecution(ctx, input)
+
+	if errs.IsA[*awstypes.AutomationExecutionNotFoundException](err) {
+		return nil, &sdkretry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AutomationExecution == nil {
+		return nil, tfresource.NewEmptyResultError()
+	}
+
+	execution := output.AutomationExecution
+
+	if execution.StepExecutionsTruncated {
+		steps, err := findAutomationStepExecutionsByID(ctx, conn, id)
+		if err != nil {
+			return nil, err
+		}
+		execution.StepExecutions = steps
+	}
+
+	return execution, nil
+}
+
+func findAutomationStepExecutionsByID(ctx context.Context, conn *ssm.Client, id string) ([]awstypes.StepExecution, error) {
+	input := &ssm.DescribeAutomationStepExecutionsInput{
+		AutomationExecutionId: aws.String(id),
+	}
+	var output []awstypes.StepExecution
+
+	pages := ssm.NewDescribeAutomationStepExecutionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.AutomationExecutionNotFoundException](err) {
+			return nil, &sdkretry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.StepExecutions...)
+	}
+
+	return output, nil
+}
+
+// automationExecutionFailureDetail renders the execution failure message and the
+// failure message and outputs of each unsuccessful step for use in a diagnostic.
+func automationExecutionFailureDetail(execution *awstypes.AutomationExecution) string {
+	if execution == nil {
+		return ""
+	}
+
+	var sb strings.Builder
+
+	if v := aws.ToString(execution.FailureMessage); v != "" {
+		fmt.Fprintf(&sb, "\n\n%s", v)
+	}
+
+	for _, step := range execution.StepExecutions {
+		switch step.StepStatus {
+		case awstypes.AutomationExecutionStatusFailed, awstypes.AutomationExecutionStatusTimedout, awstypes.AutomationExecutionStatusCancelled:
+		default:
+			continue
+		}
+
+		fmt.Fprintf(&sb, "\n\nStep %s (%s): %s", aws.ToString(step.StepName), aws.ToString(step.Action), step.StepStatus)
+		if v := aws.ToString(step.FailureMessage); v != "" {
+			fmt.Fprintf(&sb, "\n%s", v)
+		}
+		for _, k := range slices.Sorted(maps.Keys(step.Outputs)) {
+			fmt.Fprintf(&sb, "\n%s: %s", k, strings.Join(step.Outputs[k], ", "))
+		}
+	}
+
+	return sb.String()
+}
+
+// expandActionParameters converts a map of lists of strings into the document parameter
+// representation used by the Automation and Run Command APIs.
+func expandActionParameters(ctx context.Context, tfMap types.Map) (map[string][]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if tfMap.IsNull() || tfMap.IsUnknown() {
+		return nil, diags
+	}
+
+	var apiObject map[string][]string
+	diags.Append(tfMap.ElementsAs(ctx, &apiObject, false)...)
+
+	return apiObject, diags
+}
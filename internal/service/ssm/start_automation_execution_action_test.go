@@ -0,0 +1,181 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSMStartAutomationExecutionAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStartAutomationExecutionActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomationExecutionStatus(ctx, t, "aws_ssm_document.test", awstypes.AutomationExecutionStatusSuccess),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMStartAutomationExecutionAction_failed(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStartAutomationExecutionActionConfig_failed(rName),
+				ExpectError: regexache.MustCompile(`(?s)Automation Execution Failed.*Step fail \(aws:executeScript\): Failed.*expected failure`),
+			},
+		},
+	})
+}
+
+// testAccCheckAutomationExecutionStatus verifies that the most recent Automation execution of the document has the expected status.
+func testAccCheckAutomationExecutionStatus(ctx context.Context, t *testing.T, n string, want awstypes.AutomationExecutionStatus) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).SSMClient(ctx)
+
+		input := ssm.DescribeAutomationExecutionsInput{
+			Filters: []awstypes.AutomationExecutionFilter{
+				{
+					Key:    awstypes.AutomationExecutionFilterKeyDocumentNamePrefix,
+					Values: []string{rs.Primary.Attributes[names.AttrName]},
+				},
+			},
+			MaxResults: aws.Int32(1),
+		}
+		output, err := conn.DescribeAutomationExecutions(ctx, &input)
+
+		if err != nil {
+			return err
+		}
+
+		if len(output.AutomationExecutionMetadataList) == 0 {
+			return fmt.Errorf("no Automation executions found for %s", rs.Primary.Attributes[names.AttrName])
+		}
+
+		if got := output.AutomationExecutionMetadataList[0].AutomationExecutionStatus; got != want {
+			return fmt.Errorf("Automation execution status = %s, want %s", got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccStartAutomationExecutionActionConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_document" "test" {
+  name            = %[1]q
+  document_type   = "Automation"
+  document_format = "YAML"
+
+  content = <<DOC
+schemaVersion: '0.3'
+parameters:
+  Duration:
+    type: String
+mainSteps:
+  - name: sleep
+    action: aws:sleep
+    inputs:
+      Duration: '{{ Duration }}'
+DOC
+}
+
+action "aws_ssm_start_automation_execution" "test" {
+  config {
+    document_name = aws_ssm_document.test.name
+    parameters = {
+      Duration = ["PT5S"]
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_ssm_document.test.name
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_ssm_start_automation_execution.test]
+    }
+  }
+}
+`, rName)
+}
+
+func testAccStartAutomationExecutionActionConfig_failed(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_document" "test" {
+  name            = %[1]q
+  document_type   = "Automation"
+  document_format = "YAML"
+
+  content = <<DOC
+schemaVersion: '0.3'
+mainSteps:
+  - name: fail
+    action: aws:executeScript
+    inputs:
+      Runtime: python3.11
+      Handler: handler
+      Script: |-
+        def handler(events, context):
+          raise Exception("expected failure")
+DOC
+}
+
+action "aws_ssm_start_automation_execution" "test" {
+  config {
+    document_name = aws_ssm_document.test.name
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_ssm_document.test.name
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_ssm_start_automation_execution.test]
+    }
+  }
+}
+`, rName)
+}